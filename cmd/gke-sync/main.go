@@ -0,0 +1,135 @@
+// Command gke-sync adds new GKE R releases from the GKE release notes to
+// GKEProjectReleases in pkg/project/gke.go.
+//
+// Usage:
+//
+//	gke-sync [-channel stable] [-file pkg/project/gke.go] [-html release-notes.html] [-dry-run]
+//	gke-sync -gaps [-channel stable] [-html release-notes.html] [-dry-run]
+//	gke-sync -reconcile [-channel stable] [-html release-notes.html]
+//	gke-sync -backfill [-from 2022-R1] [-to 2023-R40] [-checkpoint file] [-channel stable] [-html release-notes.html] [-dry-run]
+//	gke-sync -schedule [-support-file pkg/project/gke_lifecycle.go] [-html release-schedule.html] [-dry-run]
+//
+// With -html the release notes are read from a saved copy of the page and no
+// network access is needed.
+//
+// With -gaps no releases are added. Instead every R number missing from the
+// channel's releases is looked up in the release notes, and whether it is
+// absent, has no tab for the channel or needs backfill is recorded in
+// GKEReleaseGaps.
+//
+// With -reconcile nothing is written. Every recorded release is read again
+// from the release notes and the versions missing from or extra to it, and
+// problems with its recorded source, are reported, as are releases the notes
+// list that are not recorded. The exit status is 1 when any release differs
+// or is not recorded.
+//
+// With -backfill every release between -from and -to is read, including ones
+// older than the highest release recorded: missing releases are inserted,
+// releases whose versions differ are updated and correct ones are left as
+// they are. Progress is saved to -checkpoint after each release, so running
+// the same command again after an interruption resumes where it stopped.
+//
+// With -schedule no releases are read. Instead the end of standard and
+// extended support of each Kubernetes minor is read from the GKE release
+// schedule page, or from the saved copy given with -html, into
+// GKEMinorSupport.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/abdulmajid3352/codecamp/pkg/gkesync"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+	"github.com/abdulmajid3352/codecamp/pkg/rewrite"
+)
+
+func main() {
+	var opts gkesync.Options
+	var channel string
+	var gaps, backfill, reconcile, schedule bool
+	flag.StringVar(&opts.GoFile, "file", "", "Go file holding the releases slice; defaults to the file in pkg/project declaring it")
+	flag.StringVar(&channel, "channel", string(project.DefaultChannel), "release channel to sync: rapid, regular, stable or extended")
+	flag.StringVar(&opts.Variable, "var", "", "releases slice to update; defaults to the channel's slice")
+	flag.StringVar(&opts.DetailsFile, "details", "", "Go file holding GKEReleaseDetails; defaults to the file in pkg/project declaring it")
+	flag.StringVar(&opts.HTMLFile, "html", "", "saved release notes page; fetched from the curation config URL when empty")
+	flag.StringVar(&opts.GapsFile, "gaps-file", "", "Go file holding GKEReleaseGaps; defaults to the file in pkg/project declaring it")
+	flag.StringVar(&opts.SupportFile, "support-file", "", "Go file holding GKEMinorSupport; defaults to the file in pkg/project declaring it")
+	flag.BoolVar(&schedule, "schedule", false, "record the end of support dates of the release schedule page instead of adding releases")
+	flag.BoolVar(&gaps, "gaps", false, "check the releases missing from the channel instead of adding new ones")
+	flag.BoolVar(&reconcile, "reconcile", false, "compare every recorded release with the release notes instead of adding new ones")
+	flag.BoolVar(&backfill, "backfill", false, "process every release between -from and -to instead of only new ones")
+	flag.StringVar(&opts.From, "from", "", "oldest release to backfill, e.g. 2022-R1; defaults to the oldest in the release notes")
+	flag.StringVar(&opts.To, "to", "", "newest release to backfill; defaults to the newest in the release notes")
+	flag.StringVar(&opts.Checkpoint, "checkpoint", ".gke-sync-backfill.json", "file recording backfill progress")
+	flag.BoolVar(&opts.DryRun, "dry-run", false, "print the summary without writing the file")
+	flag.Parse()
+
+	var err error
+	if opts.Channel, err = project.ParseReleaseChannel(channel); err != nil {
+		fail(err)
+	}
+	if opts.Variable == "" {
+		opts.Variable = opts.Channel.Variable()
+	}
+	if opts.GoFile == "" {
+		if opts.GoFile, err = rewrite.FindVariable("pkg/project", opts.Variable); err != nil {
+			fail(err)
+		}
+	}
+	if opts.DetailsFile == "" {
+		if opts.DetailsFile, err = rewrite.FindVariable("pkg/project", "GKEReleaseDetails"); err != nil {
+			fail(err)
+		}
+	}
+	if schedule {
+		if opts.SupportFile == "" {
+			if opts.SupportFile, err = rewrite.FindVariable("pkg/project", "GKEMinorSupport"); err != nil {
+				fail(err)
+			}
+		}
+		if _, err := gkesync.SyncSchedule(opts); err != nil {
+			fail(err)
+		}
+		return
+	}
+	if gaps {
+		if opts.GapsFile == "" {
+			if opts.GapsFile, err = rewrite.FindVariable("pkg/project", "GKEReleaseGaps"); err != nil {
+				fail(err)
+			}
+		}
+		if _, err := gkesync.CheckGaps(opts); err != nil {
+			fail(err)
+		}
+		return
+	}
+	if reconcile {
+		res, err := gkesync.Reconcile(opts)
+		if err != nil {
+			fail(err)
+		}
+		if len(res.Drifts) > 0 {
+			fail(fmt.Errorf("%d of %d releases differ from the release notes", len(res.Drifts), res.Checked))
+		}
+		if len(res.Unrecorded) > 0 {
+			fail(fmt.Errorf("%d releases of the release notes are not recorded", len(res.Unrecorded)))
+		}
+		return
+	}
+	if backfill {
+		if _, err := gkesync.Backfill(opts); err != nil {
+			fail(err)
+		}
+		return
+	}
+	if _, err := gkesync.Run(opts); err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "gke-sync:", err)
+	os.Exit(1)
+}
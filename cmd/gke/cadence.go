@@ -0,0 +1,93 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/abdulmajid3352/codecamp/pkg/cadence"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+	"github.com/abdulmajid3352/codecamp/pkg/rewrite"
+)
+
+// runCadence implements "gke cadence [-channel stable] [-format text] [-fix
+// [-file pkg/project/gke.go]]".
+func runCadence(args []string) error {
+	fs := flag.NewFlagSet("cadence", flag.ExitOnError)
+	channel := fs.String("channel", string(project.DefaultChannel), "release channel: rapid, regular, stable or extended")
+	format := fs.String("format", "text", "output format: text or json")
+	fix := fs.Bool("fix", false, "write the proposed ReleaseIntervalDays to the file declaring GKE")
+	file := fs.String("file", "", "Go file declaring GKE; defaults to the file in pkg/project declaring it")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gke cadence [-channel stable] [-format text|json] [-fix [-file pkg/project/gke.go]]")
+		fmt.Fprintln(fs.Output(), "\nCompares the observed release interval with GKE.Versioning and proposes a")
+		fmt.Fprintln(fs.Output(), "correction when they differ by more than 25%. Run from the repository root")
+		fmt.Fprintln(fs.Output(), "with -fix to apply it; only intervals measured from publication dates are")
+		fmt.Fprintln(fs.Output(), "written. The declared ReleaseCycle is not checked.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	c, err := project.ParseReleaseChannel(*channel)
+	if err != nil {
+		return err
+	}
+	rep, err := cadence.GKEAnalyze(c)
+	if err != nil {
+		return err
+	}
+	if err := writeOutput(os.Stdout, *format, rep, func(w io.Writer) { writeCadence(w, rep) }); err != nil {
+		return err
+	}
+	if !*fix || rep.Proposed == nil {
+		return nil
+	}
+	if rep.Basis != cadence.BasisDates {
+		return fmt.Errorf("not writing ReleaseIntervalDays: the observed interval is derived from %s, not publication dates", rep.Basis)
+	}
+	if *file == "" {
+		if *file, err = rewrite.FindVariable("pkg/project", "GKE"); err != nil {
+			return err
+		}
+	}
+	f, err := rewrite.Load(*file)
+	if err != nil {
+		return err
+	}
+	value := strconv.Itoa(rep.Proposed.ReleaseIntervalDays)
+	if _, err := f.SetField("GKE", []string{"Versioning", "ReleaseIntervalDays"}, value); err != nil {
+		return err
+	}
+	if err := f.Save(*file); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Set GKE.Versioning.ReleaseIntervalDays to %s in %s\n", value, *file)
+	return nil
+}
+
+func writeCadence(w io.Writer, rep *cadence.Report) {
+	fmt.Fprintf(w, "Declared: %s cycle (not checked), every %d days\n", rep.Declared.ReleaseCycle, rep.Declared.ReleaseIntervalDays)
+	for _, y := range rep.Years {
+		fmt.Fprintf(w, "%d: up to R%d, %d recorded, %d dated", y.Year, y.Numbered, y.Recorded, y.Dated)
+		if y.ImpliedDays > 0 {
+			fmt.Fprintf(w, ", about every %.1f days", y.ImpliedDays)
+		}
+		if d := y.Dates; d.Intervals > 0 {
+			fmt.Fprintf(w, ", measured %d-%d days (median %.1f)", d.MinDays, d.MaxDays, d.MedianDays)
+		}
+		fmt.Fprintln(w)
+	}
+	if rep.Basis == "" {
+		fmt.Fprintln(w, "Observed: not enough releases to tell")
+		return
+	}
+	fmt.Fprintf(w, "Observed: every %.1f days, from %s (%+.0f%%)\n", rep.ObservedDays, rep.Basis, rep.Deviation*100)
+	if p := rep.Proposed; p != nil {
+		fmt.Fprintln(w, "Proposed GKE.Versioning:")
+		fmt.Fprintf(w, "-\tReleaseIntervalDays: %d,\n", rep.Declared.ReleaseIntervalDays)
+		fmt.Fprintf(w, "+\tReleaseIntervalDays: %d,\n", p.ReleaseIntervalDays)
+	} else {
+		fmt.Fprintln(w, "Declared interval is within tolerance")
+	}
+}
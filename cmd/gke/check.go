@@ -0,0 +1,102 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/abdulmajid3352/codecamp/pkg/check"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// checkFlags are the flags of the commands that check versions against a
+// release.
+type checkFlags struct {
+	release string
+	channel string
+	format  string
+	failOn  string
+}
+
+func addCheckFlags(fs *flag.FlagSet) *checkFlags {
+	f := &checkFlags{}
+	fs.StringVar(&f.release, "release", "", "GKE release to check against; defaults to the newest of the channel")
+	fs.StringVar(&f.channel, "channel", string(project.DefaultChannel), "release channel: rapid, regular, stable or extended")
+	fs.StringVar(&f.format, "format", "text", "output format: text, json or sarif")
+	fs.StringVar(&f.failOn, "fail-on", string(check.SeverityError), "exit with status 3 on findings of this severity or worse: error, warning, note or never")
+	return f
+}
+
+// checkFormats are the -format values of the checking commands.
+var checkFormats = map[string]bool{"text": true, "json": true, "sarif": true}
+
+// validate exits with status 2 after printing the usage of fs when -format
+// or -fail-on is not valid, as flag errors do.
+func (f *checkFlags) validate(fs *flag.FlagSet) {
+	err := fmt.Errorf("unknown output format %q", f.format)
+	if checkFormats[f.format] {
+		err = nil
+		if f.failOn != "never" {
+			_, err = check.ParseSeverity(f.failOn)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(fs.Output(), "gke %s: %v\n", fs.Name(), err)
+		fs.Usage()
+		os.Exit(2)
+	}
+}
+
+// catalog returns the catalog selected by the flags.
+func (f *checkFlags) catalog() (*check.Catalog, error) {
+	c, err := project.ParseReleaseChannel(f.channel)
+	if err != nil {
+		return nil, err
+	}
+	return check.GKECatalog(f.release, c)
+}
+
+// report writes findings and returns errFindings if they fail the check.
+func (f *checkFlags) report(catalog *check.Catalog, findings []check.Finding) error {
+	var threshold check.Severity
+	if f.failOn != "never" {
+		var err error
+		if threshold, err = check.ParseSeverity(f.failOn); err != nil {
+			return err
+		}
+	}
+	if err := writeFindings(os.Stdout, f.format, catalog, findings); err != nil {
+		return err
+	}
+	if threshold != "" && check.Failed(findings, threshold) {
+		return errFindings
+	}
+	return nil
+}
+
+// writeFindings writes findings in format, with a summary line for text.
+func writeFindings(w io.Writer, format string, catalog *check.Catalog, findings []check.Finding) error {
+	if findings == nil {
+		findings = []check.Finding{}
+	}
+	if format == "sarif" {
+		return check.WriteSARIF(w, "gke", findings)
+	}
+	return writeOutput(w, format, findings, func(w io.Writer) {
+		for _, f := range findings {
+			if f.File != "" {
+				fmt.Fprint(w, f.File)
+				if f.Line > 0 {
+					fmt.Fprintf(w, ":%d", f.Line)
+				}
+				fmt.Fprint(w, ": ")
+			}
+			fmt.Fprintf(w, "%s: %s: %s [%s]\n", f.Severity, f.Subject, f.Message, f.Rule)
+			if f.Suggestion != "" {
+				fmt.Fprintf(w, "\tsuggested version: %s\n", f.Suggestion)
+			}
+		}
+		fmt.Fprintf(w, "%d finding(s) against %s (%s)\n", len(findings), catalog.Release, catalog.Channel)
+	})
+}
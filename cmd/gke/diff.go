@@ -0,0 +1,86 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/abdulmajid3352/codecamp/pkg/catalog"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// runDiff implements "gke diff [-channel stable] [-format text] FROM TO".
+func runDiff(args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	channel := fs.String("channel", string(project.DefaultChannel), "release channel: rapid, regular, stable or extended")
+	format := fs.String("format", "text", "output format: text or json")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gke diff [-channel stable] [-format text|json] FROM TO")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	c, err := project.ParseReleaseChannel(*channel)
+	if err != nil {
+		return err
+	}
+	d, err := catalog.GKEDiff(fs.Arg(0), fs.Arg(1), c)
+	if err != nil {
+		return err
+	}
+	return writeOutput(os.Stdout, *format, d, func(w io.Writer) { writeDiff(w, d) })
+}
+
+func writeDiff(w io.Writer, d *catalog.Diff) {
+	fmt.Fprintf(w, "%s -> %s (%s)\n", d.From, d.To, d.Channel)
+	fmt.Fprintf(w, "Added: %s\n", list(d.Added))
+	fmt.Fprintf(w, "Removed: %s\n", list(d.Removed))
+	fmt.Fprintf(w, "Unchanged: %s\n", list(d.Unchanged))
+	fmt.Fprintf(w, "Minors gaining support: %s\n", list(d.MinorsAdded))
+	fmt.Fprintf(w, "Minors losing support: %s\n", list(d.MinorsRemoved))
+	switch {
+	case d.DefaultKnown && d.DefaultMoved:
+		fmt.Fprintf(w, "Default: moved from %s to %s\n", d.FromDefault, d.ToDefault)
+	case d.DefaultKnown:
+		fmt.Fprintf(w, "Default: unchanged at %s\n", d.ToDefault)
+	default:
+		fmt.Fprintf(w, "Default in %s: %s\n", d.From, defaultOrUnknown(d.FromDefault))
+		fmt.Fprintf(w, "Default in %s: %s\n", d.To, defaultOrUnknown(d.ToDefault))
+	}
+}
+
+// defaultOrUnknown describes a default version that may not be recorded.
+func defaultOrUnknown(ref string) string {
+	if ref == "" {
+		return "unknown, no build details recorded"
+	}
+	return ref
+}
+
+// writeOutput writes v as indented JSON or, for the text format, with text.
+func writeOutput(w io.Writer, format string, v any, text func(io.Writer)) error {
+	switch format {
+	case "text":
+		text(w)
+		return nil
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	return fmt.Errorf("unknown output format %q", format)
+}
+
+// list joins refs for text output.
+func list(refs []string) string {
+	if len(refs) == 0 {
+		return "none"
+	}
+	return strings.Join(refs, ", ")
+}
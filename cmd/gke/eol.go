@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/abdulmajid3352/codecamp/pkg/eol"
+)
+
+// runEOL implements "gke eol [-serve ADDR] [CYCLE]".
+func runEOL(args []string) error {
+	fs := flag.NewFlagSet("eol", flag.ExitOnError)
+	serve := fs.String("serve", "", "serve the endoflife.date API on this address, e.g. localhost:8080, instead of printing")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gke eol [CYCLE]")
+		fmt.Fprintln(fs.Output(), "       gke eol -serve localhost:8080")
+		fmt.Fprintln(fs.Output(), "\nPrints the GKE cycles, or one cycle such as 1.30, in the endoflife.date")
+		fmt.Fprintln(fs.Output(), "format. With -serve, serves them at /api/gke.json and /api/gke/<cycle>.json.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() > 1 || (*serve != "" && fs.NArg() > 0) {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if *serve != "" {
+		h, err := eol.NewHandler()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Serving http://%s/api/%s.json\n", *serve, eol.Product)
+		return http.ListenAndServe(*serve, h)
+	}
+
+	var v any
+	if fs.NArg() == 1 {
+		c, err := eol.GKECycle(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		v = c
+	} else {
+		cycles, err := eol.GKECycles()
+		if err != nil {
+			return err
+		}
+		v = cycles
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/abdulmajid3352/codecamp/pkg/catalog"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// gapEntry is a missing release with the outcome recorded for it.
+type gapEntry struct {
+	Release string             `json:"release"`
+	Outcome project.GapOutcome `json:"outcome,omitempty"`
+}
+
+// runGaps implements "gke gaps [-channel stable] [-format text]".
+func runGaps(args []string) error {
+	fs := flag.NewFlagSet("gaps", flag.ExitOnError)
+	channel := fs.String("channel", string(project.DefaultChannel), "release channel: rapid, regular, stable or extended")
+	format := fs.String("format", "text", "output format: text or json")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gke gaps [-channel stable] [-format text|json]")
+		fmt.Fprintln(fs.Output(), "\nOutcomes are recorded with gke-sync -gaps.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	c, err := project.ParseReleaseChannel(*channel)
+	if err != nil {
+		return err
+	}
+	years, err := catalog.GKEMissingReleases(c)
+	if err != nil {
+		return err
+	}
+	var gaps []gapEntry
+	for _, y := range years {
+		for _, release := range y.Missing {
+			e := gapEntry{Release: release}
+			if g, err := project.GKEReleaseGap(release); err == nil {
+				e.Outcome = g.Outcomes[c]
+			}
+			gaps = append(gaps, e)
+		}
+	}
+	if gaps == nil {
+		gaps = []gapEntry{}
+	}
+	return writeOutput(os.Stdout, *format, gaps, func(w io.Writer) {
+		for _, g := range gaps {
+			outcome := string(g.Outcome)
+			if outcome == "" {
+				outcome = "unchecked"
+			}
+			fmt.Fprintf(w, "%s: %s\n", g.Release, outcome)
+		}
+		fmt.Fprintf(w, "%d missing release(s) in the %s channel\n", len(gaps), c)
+	})
+}
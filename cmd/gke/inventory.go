@@ -0,0 +1,36 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/abdulmajid3352/codecamp/pkg/check"
+)
+
+// runInventory implements "gke inventory [check flags] FILE".
+func runInventory(args []string) error {
+	fs := flag.NewFlagSet("inventory", flag.ExitOnError)
+	flags := addCheckFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gke inventory [-release 2025-R37] [-channel stable] [-format text|json|sarif] [-fail-on error] FILE")
+		fmt.Fprintln(fs.Output(), "\nFILE is the output of gcloud container clusters list --format=json, or a .csv")
+		fmt.Fprintln(fs.Output(), "file with name, location, currentMasterVersion and currentNodeVersion columns.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	flags.validate(fs)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	catalog, err := flags.catalog()
+	if err != nil {
+		return err
+	}
+	clusters, err := check.LoadClusters(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	return flags.report(catalog, catalog.CheckClusters(clusters))
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/abdulmajid3352/codecamp/pkg/catalog"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// eolCountdown is the time left until a minor's end of support; nil days
+// are not recorded.
+type eolCountdown struct {
+	catalog.Lifecycle
+	Now                         string `json:"now"`
+	DaysUntilStandardSupportEnd *int   `json:"daysUntilStandardSupportEnd"`
+	DaysUntilExtendedSupportEnd *int   `json:"daysUntilExtendedSupportEnd"`
+}
+
+// runLifecycle implements "gke lifecycle [-now YYYY-MM-DD] [-format text]
+// [MINOR]".
+func runLifecycle(args []string) error {
+	fs := flag.NewFlagSet("lifecycle", flag.ExitOnError)
+	format := fs.String("format", "text", "output format: text or json")
+	now := fs.String("now", "", "date to count days from, YYYY-MM-DD; defaults to today")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gke lifecycle [-now YYYY-MM-DD] [-format text|json] [MINOR]")
+		fmt.Fprintln(fs.Output(), "\nWithout MINOR, lists the lifecycle of every Kubernetes minor on GKE. With")
+		fmt.Fprintln(fs.Output(), "a minor such as 1.30, also shows the days until its end of support.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() > 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	when := time.Now().UTC()
+	if *now != "" {
+		var err error
+		if when, err = time.Parse(project.DateLayout, *now); err != nil {
+			return fmt.Errorf("invalid -now %q: want YYYY-MM-DD", *now)
+		}
+	}
+
+	if fs.NArg() == 1 {
+		l, err := catalog.GKELifecycle(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		c := eolCountdown{Lifecycle: *l, Now: when.Format(project.DateLayout)}
+		standard, extended, standardOK, extendedOK := l.DaysUntilEOL(when)
+		if standardOK {
+			c.DaysUntilStandardSupportEnd = &standard
+		}
+		if extendedOK {
+			c.DaysUntilExtendedSupportEnd = &extended
+		}
+		return writeOutput(os.Stdout, *format, c, func(w io.Writer) { writeCountdown(w, &c) })
+	}
+
+	all, err := catalog.GKELifecycles()
+	if err != nil {
+		return err
+	}
+	return writeOutput(os.Stdout, *format, all, func(w io.Writer) {
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "MINOR\tFIRST\tLEFT STABLE\tSTANDARD EOL\tEXTENDED EOL")
+		for _, l := range all {
+			first := orUnknown(l.FirstRelease)
+			if l.FirstPublished != "" {
+				first += " (" + l.FirstPublished + ")"
+			}
+			left := "-"
+			switch {
+			case l.OnStable:
+				left = "on Stable"
+			case l.LastStable != "":
+				left = "after " + l.LastStable
+				if l.LeftStable != "" {
+					left = l.LeftStable
+				}
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", l.Minor, first, left, orUnknown(l.EndOfStandardSupport), orUnknown(l.EndOfExtendedSupport))
+		}
+		tw.Flush()
+	})
+}
+
+func writeCountdown(w io.Writer, c *eolCountdown) {
+	fmt.Fprintf(w, "GKE %s as of %s\n", c.Minor, c.Now)
+	fmt.Fprintf(w, "first offered: %s", orUnknown(c.FirstRelease))
+	if c.FirstPublished != "" {
+		fmt.Fprintf(w, " (%s)", c.FirstPublished)
+	}
+	fmt.Fprintln(w)
+	switch {
+	case c.OnStable:
+		fmt.Fprintln(w, "Stable: still offered")
+	case c.LastStable != "":
+		fmt.Fprintf(w, "Stable: last in %s", c.LastStable)
+		if c.LeftStable != "" {
+			fmt.Fprintf(w, ", gone from %s", c.LeftStable)
+		}
+		fmt.Fprintln(w)
+	default:
+		fmt.Fprintln(w, "Stable: never offered")
+	}
+	writeEOL(w, "standard support", c.EndOfStandardSupport, c.DaysUntilStandardSupportEnd)
+	writeEOL(w, "extended support", c.EndOfExtendedSupport, c.DaysUntilExtendedSupportEnd)
+}
+
+func writeEOL(w io.Writer, label, date string, days *int) {
+	switch {
+	case days == nil:
+		fmt.Fprintf(w, "%s: end not recorded\n", label)
+	case *days < 0:
+		fmt.Fprintf(w, "%s: ended %s, %d days ago\n", label, date, -*days)
+	default:
+		fmt.Fprintf(w, "%s: ends %s, in %d days\n", label, date, *days)
+	}
+}
@@ -0,0 +1,96 @@
+// Command gke answers questions about the recorded GKE R releases.
+//
+// Usage:
+//
+//	gke <command> [flags] [args]
+//
+// Commands:
+//
+//	diff       compare the Kubernetes versions of two releases
+//	plan       plan a cluster upgrade with the versions of a release
+//	inventory  check a gcloud cluster export against a release
+//	terraform  check the versions pinned by Terraform files and plans
+//	manifests  check the versions of Config Connector and Cluster API objects
+//	gaps       list the R numbers missing from the recorded releases
+//	offered    show what a channel offered on a date, or when it offered a version
+//	cadence    compare the observed release interval with GKE.Versioning
+//	next       predict when the next release is due
+//	stale      fail when the next release is overdue and not recorded
+//	lifecycle  show when each Kubernetes minor was offered and reaches end of support
+//	eol        export or serve the minors in the endoflife.date format
+//
+// Run "gke <command> -h" for the flags of a command.
+//
+// The commands that check versions can write SARIF with -format sarif. They
+// exit with status 0 when clean, 1 when they could not run, 2 on usage
+// errors and 3 when a finding is at least as severe as -fail-on. The stale
+// command exits with status 3 when the catalog is stale.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// command runs a subcommand with its arguments.
+type command struct {
+	summary string
+	run     func(args []string) error
+}
+
+var commands = map[string]command{
+	"diff":      {"compare the Kubernetes versions of two releases", runDiff},
+	"plan":      {"plan a cluster upgrade with the versions of a release", runPlan},
+	"inventory": {"check a gcloud cluster export against a release", runInventory},
+	"terraform": {"check the versions pinned by Terraform files and plans", runTerraform},
+	"manifests": {"check the versions of Config Connector and Cluster API objects", runManifests},
+	"gaps":      {"list the R numbers missing from the recorded releases", runGaps},
+	"offered":   {"show what a channel offered on a date, or when it offered a version", runOffered},
+	"cadence":   {"compare the observed release interval with GKE.Versioning", runCadence},
+	"next":      {"predict when the next release is due", runNext},
+	"stale":     {"fail when the next release is overdue and not recorded", runStale},
+	"lifecycle": {"show when each Kubernetes minor was offered and reaches end of support", runLifecycle},
+	"eol":       {"export or serve the minors in the endoflife.date format", runEOL},
+}
+
+// errFindings is returned by checking commands that reported an error
+// finding; it exits with status 3 without a message.
+var errFindings = errors.New("checks failed")
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "gke: unknown command %q\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+	if err := cmd.run(os.Args[2:]); err != nil {
+		if errors.Is(err, errFindings) {
+			os.Exit(3)
+		}
+		fail(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: gke <command> [flags] [args]\n\ncommands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", name, commands[name].summary)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "gke:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/abdulmajid3352/codecamp/pkg/check"
+)
+
+// runManifests implements "gke manifests [check flags] PATH...".
+func runManifests(args []string) error {
+	fs := flag.NewFlagSet("manifests", flag.ExitOnError)
+	flags := addCheckFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gke manifests [-release 2025-R37] [-channel stable] [-format text|json|sarif] [-fail-on error] PATH...")
+		fmt.Fprintln(fs.Output(), "\nEach PATH is a YAML file or a directory of .yaml and .yml files holding Config")
+		fmt.Fprintln(fs.Output(), "Connector ContainerCluster or ContainerNodePool or Cluster API")
+		fmt.Fprintln(fs.Output(), "GCPManagedControlPlane objects.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	flags.validate(fs)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	catalog, err := flags.catalog()
+	if err != nil {
+		return err
+	}
+	pins, err := check.ScanManifests(fs.Args())
+	if err != nil {
+		return err
+	}
+	return flags.report(catalog, catalog.CheckPins(pins))
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/abdulmajid3352/codecamp/pkg/catalog"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// window is when a Kubernetes version was offered by a channel.
+type window struct {
+	Ref       string `json:"ref"`
+	FirstSeen string `json:"firstSeen"`
+	LastSeen  string `json:"lastSeen"`
+	Since     string `json:"since,omitempty"`
+	Until     string `json:"until,omitempty"`
+}
+
+// runOffered implements "gke offered [-channel stable] [-format text]
+// <YYYY-MM-DD|kube@x.y.z>".
+func runOffered(args []string) error {
+	fs := flag.NewFlagSet("offered", flag.ExitOnError)
+	channel := fs.String("channel", string(project.DefaultChannel), "release channel: rapid, regular, stable or extended")
+	format := fs.String("format", "text", "output format: text or json")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gke offered [-channel stable] [-format text|json] <YYYY-MM-DD|kube@x.y.z|kube@x.y>")
+		fmt.Fprintln(fs.Output(), "\nWith a date, lists the versions the channel offered that day. With a")
+		fmt.Fprintln(fs.Output(), "version, shows the releases and dates it was offered between.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	c, err := project.ParseReleaseChannel(*channel)
+	if err != nil {
+		return err
+	}
+	x, err := catalog.GKEIndex(c)
+	if err != nil {
+		return err
+	}
+
+	arg := fs.Arg(0)
+	if strings.HasPrefix(arg, "kube@") {
+		o, err := x.Lookup(arg)
+		if err != nil {
+			return err
+		}
+		w := window{Ref: o.Ref, FirstSeen: o.FirstSeen, LastSeen: o.LastSeen, Since: o.Since, Until: o.Until}
+		return writeOutput(os.Stdout, *format, w, func(out io.Writer) {
+			fmt.Fprintf(out, "%s offered on %s from %s to %s\n", w.Ref, c, w.FirstSeen, w.LastSeen)
+			fmt.Fprintf(out, "since: %s\n", orUnknown(w.Since))
+			switch {
+			case w.Until != "":
+				fmt.Fprintf(out, "until: %s\n", w.Until)
+			case o.LastSeen == x.Newest():
+				fmt.Fprintln(out, "until: still offered")
+			default:
+				fmt.Fprintln(out, "until: unknown")
+			}
+		})
+	}
+	date, err := time.Parse(project.DateLayout, arg)
+	if err != nil {
+		return fmt.Errorf("invalid date %q: want YYYY-MM-DD or a kube@ reference", arg)
+	}
+	o, err := x.OfferedOn(date)
+	if err != nil {
+		return err
+	}
+	return writeOutput(os.Stdout, *format, o, func(w io.Writer) {
+		published := o.Published
+		if o.Estimated {
+			published += " (estimated)"
+		}
+		fmt.Fprintf(w, "%s on %s: %s, published %s\n", c, o.Date, o.Release, published)
+		fmt.Fprintf(w, "versions: %s\n", list(o.Versions))
+		if len(o.Unconfirmed) > 0 {
+			fmt.Fprintf(w, "unconfirmed: %s\n", list(o.Unconfirmed))
+		}
+		if o.Estimated || len(o.Unconfirmed) > 0 {
+			fmt.Fprintln(w, "Publication dates are estimated from release numbers where none is recorded;")
+			fmt.Fprintln(w, "record them with gke-sync -backfill.")
+		}
+	})
+}
+
+// orUnknown returns s, or "unknown" when it is empty.
+func orUnknown(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+	return s
+}
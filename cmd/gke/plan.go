@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/abdulmajid3352/codecamp/pkg/catalog"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// runPlan implements "gke plan [-release R] [-channel stable] [-format text]
+// CURRENT TARGET".
+func runPlan(args []string) error {
+	fs := flag.NewFlagSet("plan", flag.ExitOnError)
+	release := fs.String("release", "", "GKE release whose versions to use; defaults to the newest of the channel")
+	channel := fs.String("channel", string(project.DefaultChannel), "release channel: rapid, regular, stable or extended")
+	format := fs.String("format", "text", "output format: text or json")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gke plan [-release 2025-R37] [-channel stable] [-format text|json] CURRENT TARGET")
+		fmt.Fprintln(fs.Output(), "\nCURRENT is the cluster's x.y.z version and TARGET the x.y minor to reach.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	c, err := project.ParseReleaseChannel(*channel)
+	if err != nil {
+		return err
+	}
+	if *release == "" {
+		if *release, err = newestRelease(c); err != nil {
+			return err
+		}
+	}
+	plan, err := catalog.GKEPlanUpgrade(fs.Arg(0), fs.Arg(1), *release, c)
+	if err != nil {
+		return err
+	}
+	return writeOutput(os.Stdout, *format, plan, func(w io.Writer) { writePlan(w, plan) })
+}
+
+func writePlan(w io.Writer, plan *catalog.Plan) {
+	fmt.Fprintf(w, "Upgrade %s to %s with %s (%s)\n", plan.From, plan.Target, plan.Release, plan.Channel)
+	if len(plan.Steps) == 0 {
+		fmt.Fprintln(w, "Already at or past the newest version offered")
+		return
+	}
+	for i, s := range plan.Steps {
+		fmt.Fprintf(w, "%d. %s -> %s\n", i+1, s.From, s.To)
+	}
+}
+
+// newestRelease returns the newest recorded GKE release of channel.
+func newestRelease(channel project.ReleaseChannel) (string, error) {
+	releases, err := project.GKEReleases(channel)
+	if err != nil {
+		return "", err
+	}
+	if len(releases) == 0 {
+		return "", fmt.Errorf("no GKE releases recorded for the %s channel", channel)
+	}
+	return releases[0].Version, nil
+}
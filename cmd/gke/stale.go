@@ -0,0 +1,160 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/abdulmajid3352/codecamp/pkg/cadence"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// runNext implements "gke next [-channel stable] [-format text]".
+func runNext(args []string) error {
+	fs := flag.NewFlagSet("next", flag.ExitOnError)
+	channel := fs.String("channel", string(project.DefaultChannel), "release channel: rapid, regular, stable or extended")
+	format := fs.String("format", "text", "output format: text or json")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gke next [-channel stable] [-format text|json]")
+		fmt.Fprintln(fs.Output(), "\nPredicts when the release after the newest recorded one is due.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	c, err := project.ParseReleaseChannel(*channel)
+	if err != nil {
+		return err
+	}
+	releases, err := cadence.GKEReleases(c)
+	if err != nil {
+		return err
+	}
+	pred, err := cadence.Predict(&project.GKE, releases)
+	if err != nil {
+		return err
+	}
+	return writeOutput(os.Stdout, *format, pred, func(w io.Writer) { writePrediction(w, pred) })
+}
+
+// runStale implements "gke stale [-channel stable] [-now YYYY-MM-DD]
+// [-format text] [-metrics FILE]".
+func runStale(args []string) error {
+	fs := flag.NewFlagSet("stale", flag.ExitOnError)
+	channel := fs.String("channel", string(project.DefaultChannel), "release channel: rapid, regular, stable or extended")
+	format := fs.String("format", "text", "output format: text or json")
+	now := fs.String("now", "", "date to check as of, YYYY-MM-DD; defaults to today")
+	metrics := fs.String("metrics", "", "write Prometheus metrics to this file, or - for standard output")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gke stale [-channel stable] [-now YYYY-MM-DD] [-format text|json] [-metrics FILE]")
+		fmt.Fprintln(fs.Output(), "\nExits with status 3 when the next release is past its predicted deadline")
+		fmt.Fprintln(fs.Output(), "and not recorded.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	c, err := project.ParseReleaseChannel(*channel)
+	if err != nil {
+		return err
+	}
+	when := time.Now().UTC()
+	if *now != "" {
+		if when, err = time.Parse(project.DateLayout, *now); err != nil {
+			return fmt.Errorf("invalid -now %q: want YYYY-MM-DD", *now)
+		}
+	}
+	releases, err := cadence.GKEReleases(c)
+	if err != nil {
+		return err
+	}
+	s, err := cadence.CheckStale(&project.GKE, releases, when)
+	if err != nil {
+		return err
+	}
+	if *metrics != "" {
+		if err := writeMetricsFile(*metrics, c, s); err != nil {
+			return err
+		}
+	}
+	if *metrics != "-" {
+		err := writeOutput(os.Stdout, *format, s, func(w io.Writer) {
+			writePrediction(w, &s.Prediction)
+			if s.Stale {
+				fmt.Fprintf(w, "STALE: %s is %d days past its deadline as of %s\n", s.Next, s.OverdueDays, s.Now)
+			} else {
+				fmt.Fprintf(w, "Up to date as of %s\n", s.Now)
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if s.Stale {
+		return errFindings
+	}
+	return nil
+}
+
+func writePrediction(w io.Writer, pred *cadence.Prediction) {
+	basis := "declared interval"
+	if pred.Basis != "" {
+		basis = string(pred.Basis)
+	}
+	fmt.Fprintf(w, "Newest recorded: %s\n", pred.Newest)
+	if pred.Estimated {
+		fmt.Fprintf(w, "Counting from: %s, estimated published %s from its release number\n", pred.Anchor, pred.Published)
+	} else {
+		fmt.Fprintf(w, "Counting from: %s, published %s\n", pred.Anchor, pred.Published)
+	}
+	fmt.Fprintf(w, "Interval: %.1f days, from %s\n", pred.IntervalDays, basis)
+	fmt.Fprintf(w, "Next: %s due %s, deadline %s\n", pred.Next, pred.Due, pred.Deadline)
+}
+
+// writeMetricsFile writes the metrics of s to path, or standard output for
+// "-", in the Prometheus text format read by the node exporter's textfile
+// collector.
+func writeMetricsFile(path string, channel project.ReleaseChannel, s *cadence.Staleness) error {
+	if path == "-" {
+		return writeMetrics(os.Stdout, channel, s)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := writeMetrics(f, channel, s); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func writeMetrics(w io.Writer, channel project.ReleaseChannel, s *cadence.Staleness) error {
+	due, _ := time.Parse(project.DateLayout, s.Due)
+	deadline, _ := time.Parse(project.DateLayout, s.Deadline)
+	stale, estimated := 0, 0
+	if s.Stale {
+		stale = 1
+	}
+	if s.Estimated {
+		estimated = 1
+	}
+	labels := fmt.Sprintf("channel=%q,newest=%q", channel, s.Newest)
+	for _, m := range []struct {
+		name, help string
+		value      float64
+	}{
+		{"gke_catalog_stale", "Whether the next GKE release is past its deadline and not recorded.", float64(stale)},
+		{"gke_catalog_anchor_age_days", "Days since the GKE release counted from was published, or estimated to be.", float64(s.AgeDays)},
+		{"gke_catalog_anchor_estimated", "Whether the publication date counted from is estimated from a release number.", float64(estimated)},
+		{"gke_catalog_overdue_days", "Days past the deadline of the next GKE release; negative while it is not yet due.", float64(s.OverdueDays)},
+		{"gke_catalog_release_interval_days", "Interval assumed between GKE releases.", s.IntervalDays},
+		{"gke_catalog_next_release_due_timestamp_seconds", "When the next GKE release is expected.", float64(due.Unix())},
+		{"gke_catalog_next_release_deadline_timestamp_seconds", "When the catalog counts as stale without the next GKE release.", float64(deadline.Unix())},
+	} {
+		value := strconv.FormatFloat(m.value, 'f', -1, 64)
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n%s{%s} %s\n", m.name, m.help, m.name, m.name, labels, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/abdulmajid3352/codecamp/pkg/check"
+)
+
+// runTerraform implements "gke terraform [check flags] PATH...".
+func runTerraform(args []string) error {
+	fs := flag.NewFlagSet("terraform", flag.ExitOnError)
+	flags := addCheckFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gke terraform [-release 2025-R37] [-channel stable] [-format text|json|sarif] [-fail-on error] PATH...")
+		fmt.Fprintln(fs.Output(), "\nEach PATH is a .tf file, a directory of .tf files or a plan saved with")
+		fmt.Fprintln(fs.Output(), "terraform show -json as a .json file.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	flags.validate(fs)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	catalog, err := flags.catalog()
+	if err != nil {
+		return err
+	}
+	pins, err := check.ScanTerraform(fs.Args())
+	if err != nil {
+		return err
+	}
+	return flags.report(catalog, catalog.CheckPins(pins))
+}
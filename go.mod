@@ -0,0 +1,26 @@
+module github.com/abdulmajid3352/codecamp
+
+go 1.24.0
+
+// github.com/chkk-io/schema is private and is not served by the public
+// module proxy. Require it at the version the schema repository publishes:
+//
+//	GOPRIVATE=github.com/chkk-io go get github.com/chkk-io/schema@<version>
+
+require (
+	github.com/andybalholm/cascadia v1.3.3
+	github.com/hashicorp/hcl/v2 v2.24.0
+	github.com/zclconf/go-cty v1.16.3
+	golang.org/x/net v0.47.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/agext/levenshtein v1.2.1 // indirect
+	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
+	github.com/mitchellh/go-wordwrap v1.0.1 // indirect
+	golang.org/x/mod v0.29.0 // indirect
+	golang.org/x/sync v0.18.0 // indirect
+	golang.org/x/text v0.31.0 // indirect
+	golang.org/x/tools v0.38.0 // indirect
+)
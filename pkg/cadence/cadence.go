@@ -0,0 +1,247 @@
+// Package cadence measures how often a project actually releases and
+// compares it with the ReleaseCycle and ReleaseIntervalDays its Versioning
+// declares.
+//
+// Intervals are measured between consecutive dated releases. When too few
+// releases are dated, the interval is estimated from how many releases the
+// most recent full year was numbered up to, e.g. 2024-R50 implies a release
+// about every seven days. Only the interval is compared; the declared
+// ReleaseCycle is not checked.
+package cadence
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// MinIntervals is the number of measured intervals needed to base a report
+// on release dates rather than release numbers.
+const MinIntervals = 4
+
+// Tolerance is the relative difference between the observed and declared
+// interval above which a correction is proposed.
+const Tolerance = 0.25
+
+// Basis is what an observed interval was derived from.
+type Basis string
+
+const (
+	BasisDates   Basis = "dates"
+	BasisNumbers Basis = "release-numbers"
+)
+
+// Release is a release with its publication date, zero when unknown.
+type Release struct {
+	Version   string
+	Published time.Time
+}
+
+// Distribution describes a set of intervals between releases, in days.
+type Distribution struct {
+	Intervals  int     `json:"intervals"`
+	MinDays    int     `json:"minDays,omitempty"`
+	MaxDays    int     `json:"maxDays,omitempty"`
+	MedianDays float64 `json:"medianDays,omitempty"`
+	MeanDays   float64 `json:"meanDays,omitempty"`
+	// Days counts the intervals of each length.
+	Days map[int]int `json:"days,omitempty"`
+}
+
+// Year is the cadence of the releases of one year.
+type Year struct {
+	Year int `json:"year"`
+	// Numbered is the highest release number of the year, so the number of
+	// releases made, whether recorded or not.
+	Numbered int `json:"numbered"`
+	Recorded int `json:"recorded"`
+	Dated    int `json:"dated"`
+	// ImpliedDays is the interval implied by Numbered releases in a full
+	// year. It is zero for the newest year, which may not be over.
+	ImpliedDays float64      `json:"impliedDays,omitempty"`
+	Dates       Distribution `json:"dates"`
+}
+
+// Declared is the cadence a project's Versioning declares.
+type Declared struct {
+	ReleaseCycle        model.ReleaseCycle `json:"releaseCycle"`
+	ReleaseIntervalDays int                `json:"releaseIntervalDays"`
+}
+
+// Report compares the observed cadence of a project with the declared one.
+type Report struct {
+	Project  string       `json:"project"`
+	Declared Declared     `json:"declared"`
+	Years    []Year       `json:"years"`
+	Dates    Distribution `json:"dates"`
+	// Basis and ObservedDays are the observed interval and what it was
+	// derived from; ObservedDays is zero when neither dates nor release
+	// numbers are enough.
+	Basis        Basis   `json:"basis,omitempty"`
+	ObservedDays float64 `json:"observedDays,omitempty"`
+	// Deviation is ObservedDays relative to the declared interval, e.g.
+	// -0.92 for a 7 day cadence declared as 90 days.
+	Deviation float64 `json:"deviation,omitempty"`
+	// Proposed is the corrected Versioning when the deviation exceeds
+	// Tolerance, or nil. Its ReleaseCycle is the declared one, which is not
+	// checked.
+	Proposed *Declared `json:"proposed,omitempty"`
+}
+
+// Analyze measures the cadence of releases, which must be valid releases of
+// p, and compares it with p.Versioning.
+func Analyze(p *model.Project, releases []Release) (*Report, error) {
+	if p.Versioning == nil {
+		return nil, fmt.Errorf("%s declares no versioning", p.ID)
+	}
+	parser, err := calver.ForProject(p)
+	if err != nil {
+		return nil, err
+	}
+	type dated struct {
+		version   calver.Version
+		published time.Time
+	}
+	sorted := make([]dated, len(releases))
+	for i, r := range releases {
+		v, err := parser.Parse(r.Version)
+		if err != nil {
+			return nil, err
+		}
+		sorted[i] = dated{v, r.Published}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].version.Less(sorted[j].version) })
+
+	rep := &Report{
+		Project: p.ID,
+		Declared: Declared{
+			ReleaseCycle:        p.Versioning.ReleaseCycle,
+			ReleaseIntervalDays: p.Versioning.ReleaseIntervalDays,
+		},
+	}
+	years := map[int]*Year{}
+	intervals := map[int][]int{}
+	var all []int
+	for i, r := range sorted {
+		y := years[r.version.Year]
+		if y == nil {
+			y = &Year{Year: r.version.Year}
+			years[r.version.Year] = y
+		}
+		y.Recorded++
+		y.Numbered = max(y.Numbered, r.version.Minor)
+		if r.published.IsZero() {
+			continue
+		}
+		y.Dated++
+		if i == 0 {
+			continue
+		}
+		prev := sorted[i-1]
+		if prev.published.IsZero() || !adjacent(prev.version, r.version) {
+			continue
+		}
+		days := int(math.Round(r.published.Sub(prev.published).Hours() / 24))
+		intervals[r.version.Year] = append(intervals[r.version.Year], days)
+		all = append(all, days)
+	}
+
+	for _, y := range years {
+		y.Dates = distribution(intervals[y.Year])
+		rep.Years = append(rep.Years, *y)
+	}
+	sort.Slice(rep.Years, func(i, j int) bool { return rep.Years[i].Year < rep.Years[j].Year })
+	// implied is the interval of the most recent full year.
+	var implied float64
+	for i := range rep.Years {
+		if y := &rep.Years[i]; i < len(rep.Years)-1 && y.Numbered > 0 {
+			y.ImpliedDays = round1(daysInYear(y.Year) / float64(y.Numbered))
+			implied = y.ImpliedDays
+		}
+	}
+	rep.Dates = distribution(all)
+
+	switch {
+	case rep.Dates.Intervals >= MinIntervals:
+		rep.Basis, rep.ObservedDays = BasisDates, rep.Dates.MedianDays
+	case implied > 0:
+		rep.Basis, rep.ObservedDays = BasisNumbers, implied
+	default:
+		return rep, nil
+	}
+	if declared := rep.Declared.ReleaseIntervalDays; declared > 0 {
+		rep.Deviation = round2(rep.ObservedDays/float64(declared) - 1)
+		if math.Abs(rep.Deviation) > Tolerance {
+			rep.Proposed = &Declared{
+				ReleaseCycle:        rep.Declared.ReleaseCycle,
+				ReleaseIntervalDays: max(1, int(math.Round(rep.ObservedDays))),
+			}
+		}
+	}
+	return rep, nil
+}
+
+// GKEAnalyze measures the cadence of the GKE releases of channel; see
+// GKEReleases.
+func GKEAnalyze(channel project.ReleaseChannel) (*Report, error) {
+	releases, err := GKEReleases(channel)
+	if err != nil {
+		return nil, err
+	}
+	return Analyze(&project.GKE, releases)
+}
+
+// adjacent reports whether b is the release numbered right after a, within
+// a year or as the first release of the next one.
+func adjacent(a, b calver.Version) bool {
+	if a.Year == b.Year {
+		return b.Minor == a.Minor+1
+	}
+	return b.Year == a.Year+1 && b.Minor == 1
+}
+
+func distribution(days []int) Distribution {
+	d := Distribution{Intervals: len(days)}
+	if len(days) == 0 {
+		return d
+	}
+	sorted := append([]int(nil), days...)
+	sort.Ints(sorted)
+	d.MinDays, d.MaxDays = sorted[0], sorted[len(sorted)-1]
+	d.Days = map[int]int{}
+	values := make([]float64, len(sorted))
+	sum := 0
+	for i, n := range sorted {
+		d.Days[n]++
+		values[i] = float64(n)
+		sum += n
+	}
+	d.MedianDays = median(values)
+	d.MeanDays = round1(float64(sum) / float64(len(sorted)))
+	return d
+}
+
+// median returns the median of values.
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return round1((sorted[n/2-1] + sorted[n/2]) / 2)
+}
+
+func daysInYear(year int) float64 {
+	return time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC).Sub(time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)).Hours() / 24
+}
+
+func round1(f float64) float64 { return math.Round(f*10) / 10 }
+
+func round2(f float64) float64 { return math.Round(f*100) / 100 }
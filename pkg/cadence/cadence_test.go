@@ -0,0 +1,93 @@
+package cadence
+
+import (
+	"testing"
+
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// withDates sets the publication dates of releases, keyed by version.
+func withDates(t *testing.T, releases []Release, dates map[string]string) []Release {
+	t.Helper()
+	for i := range releases {
+		if d, ok := dates[releases[i].Version]; ok {
+			releases[i].Published = date(t, d)
+		}
+	}
+	return releases
+}
+
+func TestAnalyzeDates(t *testing.T) {
+	releases := withDates(t, numbered(map[int]int{2024: 50, 2025: 6}), map[string]string{
+		"2025-R01": "2025-01-07",
+		"2025-R02": "2025-01-14",
+		"2025-R03": "2025-01-21",
+		"2025-R04": "2025-01-29",
+		"2025-R05": "2025-02-04",
+		// Not adjacent to a dated release, so no interval is measured.
+		"2024-R10": "2024-03-20",
+	})
+	rep, err := Analyze(&project.GKE, releases)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rep.Dates.Intervals != 4 || rep.Dates.MinDays != 6 || rep.Dates.MaxDays != 8 || rep.Dates.MedianDays != 7 {
+		t.Errorf("Dates = %+v, want 4 intervals of 6 to 8 days, median 7", rep.Dates)
+	}
+	if rep.Basis != BasisDates || rep.ObservedDays != 7 {
+		t.Errorf("Analyze observed %.1f days from %s, want 7 from %s", rep.ObservedDays, rep.Basis, BasisDates)
+	}
+	// GKE declares 90 days.
+	if rep.Deviation != -0.92 || rep.Proposed == nil || rep.Proposed.ReleaseIntervalDays != 7 {
+		t.Errorf("Analyze deviates %.2f, proposing %+v; want -0.92 proposing 7 days", rep.Deviation, rep.Proposed)
+	}
+}
+
+func TestAnalyzeNumbers(t *testing.T) {
+	// 2023 implies 14 days and 2024 7.3; the most recent full year is used,
+	// as Predict does.
+	rep, err := Analyze(&project.GKE, numbered(map[int]int{2023: 26, 2024: 50, 2025: 37}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rep.Basis != BasisNumbers || rep.ObservedDays != 7.3 {
+		t.Errorf("Analyze observed %.1f days from %s, want 7.3 from %s", rep.ObservedDays, rep.Basis, BasisNumbers)
+	}
+	if len(rep.Years) != 3 || rep.Years[0].ImpliedDays != 14 || rep.Years[2].ImpliedDays != 0 {
+		t.Errorf("Years = %+v, want 2023 implying 14 days and 2025, not over, none", rep.Years)
+	}
+	pred, err := Predict(&project.GKE, numbered(map[int]int{2023: 26, 2024: 50, 2025: 37}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pred.IntervalDays != rep.ObservedDays || pred.Basis != rep.Basis {
+		t.Errorf("Predict assumes %.1f days from %s, Analyze observes %.1f from %s", pred.IntervalDays, pred.Basis, rep.ObservedDays, rep.Basis)
+	}
+}
+
+func TestAnalyzeBelowMinIntervals(t *testing.T) {
+	dates := map[string]string{
+		"2025-R01": "2025-01-01",
+		"2025-R02": "2025-01-20",
+		"2025-R03": "2025-02-08",
+		"2025-R04": "2025-02-27",
+	}
+	rep, err := Analyze(&project.GKE, withDates(t, numbered(map[int]int{2024: 50, 2025: 4}), dates))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Three measured intervals are too few, so release numbers are used.
+	if rep.Dates.Intervals != MinIntervals-1 || rep.Basis != BasisNumbers || rep.ObservedDays != 7.3 {
+		t.Errorf("Analyze measured %d intervals and observed %.1f days from %s, want %d and 7.3 from %s",
+			rep.Dates.Intervals, rep.ObservedDays, rep.Basis, MinIntervals-1, BasisNumbers)
+	}
+
+	// Without a full year either, nothing is observed.
+	rep, err = Analyze(&project.GKE, withDates(t, numbered(map[int]int{2025: 4}), dates))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rep.Basis != "" || rep.ObservedDays != 0 || rep.Proposed != nil {
+		t.Errorf("Analyze of 3 intervals in one year = %s, %.1f days, proposing %+v; want nothing observed", rep.Basis, rep.ObservedDays, rep.Proposed)
+	}
+}
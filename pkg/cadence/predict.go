@@ -0,0 +1,169 @@
+package cadence
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// Prediction is when the release after the newest recorded one is due.
+type Prediction struct {
+	// Newest is the newest recorded release and Next the one predicted,
+	// assuming it is numbered in the same year.
+	Newest string `json:"newest"`
+	Next   string `json:"next"`
+	// Anchor is the newest dated release the prediction counts from, and
+	// Published its publication date. When Estimated is set, no release of
+	// Newest's year is dated, so Anchor is Newest and Published is estimated
+	// from its release number.
+	Anchor    string `json:"anchor"`
+	Published string `json:"published"`
+	Estimated bool   `json:"estimated,omitempty"`
+	// IntervalDays is the interval assumed between releases, from Basis,
+	// or from the declared ReleaseIntervalDays when Basis is empty.
+	IntervalDays float64 `json:"intervalDays"`
+	Basis        Basis   `json:"basis,omitempty"`
+	// Due is the date Next is expected and Deadline the last date it can
+	// be published before the catalog counts as stale.
+	Due      string `json:"due"`
+	Deadline string `json:"deadline"`
+}
+
+// Predict returns when the release after the newest of releases is due. It
+// counts one interval per release from the newest dated one, so releases
+// recorded without a date still move the prediction forward. When no
+// release of the newest one's year is dated, counting across years would
+// compound any error in the interval, so the newest release is taken as
+// published one interval per release number into its year instead. The
+// interval is the one Analyze observes. The deadline allows for the longest interval measured, or
+// Tolerance of the interval when none was.
+func Predict(p *model.Project, releases []Release) (*Prediction, error) {
+	rep, err := Analyze(p, releases)
+	if err != nil {
+		return nil, err
+	}
+	parser, err := calver.ForProject(p)
+	if err != nil {
+		return nil, err
+	}
+	var newest, anchor calver.Version
+	var published time.Time
+	for _, r := range releases {
+		v, err := parser.Parse(r.Version)
+		if err != nil {
+			return nil, err
+		}
+		if newest.Less(v) {
+			newest = v
+		}
+		if !r.Published.IsZero() && anchor.Less(v) {
+			anchor, published = v, r.Published
+		}
+	}
+	if newest.IsZero() {
+		return nil, fmt.Errorf("no %s releases recorded", p.ID)
+	}
+
+	pred := &Prediction{
+		Newest:       parser.Format(newest),
+		Next:         parser.Format(calver.Version{Year: newest.Year, Minor: newest.Minor + 1}),
+		IntervalDays: rep.ObservedDays,
+		Basis:        rep.Basis,
+	}
+	if anchor.IsZero() || anchor.Year < newest.Year {
+		anchor, pred.Estimated = newest, true
+	}
+	if pred.IntervalDays == 0 {
+		pred.IntervalDays, pred.Basis = float64(rep.Declared.ReleaseIntervalDays), ""
+	}
+	if pred.IntervalDays <= 0 {
+		return nil, fmt.Errorf("%s declares no release interval and too few releases are recorded to measure one", p.ID)
+	}
+	if pred.Estimated {
+		start := time.Date(newest.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		published = start.AddDate(0, 0, int(math.Round(float64(newest.Minor-1)*pred.IntervalDays)))
+	}
+	pred.Anchor = parser.Format(anchor)
+	pred.Published = published.Format(project.DateLayout)
+	// steps counts the releases from the anchor to Next, taking each year
+	// to end at its highest recorded number.
+	numbered := map[int]int{}
+	for _, y := range rep.Years {
+		numbered[y.Year] = y.Numbered
+	}
+	steps := 1 + newest.Minor - anchor.Minor
+	for y := anchor.Year; y < newest.Year; y++ {
+		steps += numbered[y]
+	}
+	grace := pred.IntervalDays * Tolerance
+	if rep.Dates.Intervals > 0 {
+		grace = max(grace, float64(rep.Dates.MaxDays)-pred.IntervalDays)
+	}
+	due := published.AddDate(0, 0, int(math.Round(float64(steps)*pred.IntervalDays)))
+	pred.Due = due.Format(project.DateLayout)
+	pred.Deadline = due.AddDate(0, 0, max(1, int(math.Ceil(grace)))).Format(project.DateLayout)
+	return pred, nil
+}
+
+// Staleness is whether a catalog missed its predicted next release.
+type Staleness struct {
+	Prediction
+	// Now is the date checked, and AgeDays how long ago the anchor was
+	// published by then.
+	Now     string `json:"now"`
+	AgeDays int    `json:"ageDays"`
+	// OverdueDays counts the days past the deadline; it is positive when
+	// the catalog is stale.
+	OverdueDays int  `json:"overdueDays"`
+	Stale       bool `json:"stale"`
+}
+
+// CheckStale reports whether the catalog of releases should have recorded
+// the predicted next release by now.
+func CheckStale(p *model.Project, releases []Release, now time.Time) (*Staleness, error) {
+	pred, err := Predict(p, releases)
+	if err != nil {
+		return nil, err
+	}
+	published, _ := time.Parse(project.DateLayout, pred.Published)
+	deadline, _ := time.Parse(project.DateLayout, pred.Deadline)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	s := &Staleness{
+		Prediction:  *pred,
+		Now:         today.Format(project.DateLayout),
+		AgeDays:     days(today.Sub(published)),
+		OverdueDays: days(today.Sub(deadline)),
+	}
+	s.Stale = s.OverdueDays > 0
+	return s, nil
+}
+
+// GKEReleases returns the GKE releases of channel dated with the
+// publication dates in project.GKEReleaseDetails.
+func GKEReleases(channel project.ReleaseChannel) ([]Release, error) {
+	recorded, err := project.GKEReleases(channel)
+	if err != nil {
+		return nil, err
+	}
+	releases := make([]Release, len(recorded))
+	for i, r := range recorded {
+		releases[i].Version = r.Version
+		d, err := project.GKEReleaseDetail(r.Version)
+		if err != nil {
+			continue
+		}
+		if releases[i].Published, err = d.PublishedOn(); err != nil {
+			return nil, fmt.Errorf("GKE release %s: %w", r.Version, err)
+		}
+	}
+	return releases, nil
+}
+
+func days(d time.Duration) int {
+	return int(math.Floor(d.Hours() / 24))
+}
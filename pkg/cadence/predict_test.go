@@ -0,0 +1,88 @@
+package cadence
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+func date(t *testing.T, s string) time.Time {
+	t.Helper()
+	d, err := time.Parse(project.DateLayout, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+// numbered returns releases R1 to Rn of each year, without dates.
+func numbered(years map[int]int) []Release {
+	var releases []Release
+	for year, n := range years {
+		for minor := 1; minor <= n; minor++ {
+			releases = append(releases, Release{Version: fmt.Sprintf("%d-R%02d", year, minor)})
+		}
+	}
+	return releases
+}
+
+func TestCheckStaleWithoutDates(t *testing.T) {
+	// 2024 numbered 50 releases, about one every 7.3 days, so 2025-R37 is
+	// estimated published on 2025-09-21 and 2025-R38 due a week later. No
+	// interval is measured, so the deadline allows two days.
+	releases := numbered(map[int]int{2024: 50, 2025: 37})
+	tests := []struct {
+		now     string
+		stale   bool
+		overdue int
+	}{
+		{now: "2025-09-25", stale: false},
+		{now: "2026-10-18", stale: true, overdue: 383},
+	}
+	for _, tt := range tests {
+		s, err := CheckStale(&project.GKE, releases, date(t, tt.now))
+		if err != nil {
+			t.Fatalf("CheckStale(%s): %v", tt.now, err)
+		}
+		if !s.Estimated || s.Anchor != "2025-R37" || s.Published != "2025-09-21" || s.Basis != BasisNumbers {
+			t.Errorf("CheckStale(%s) counts from %s published %s (estimated %v, basis %s), want 2025-R37 estimated 2025-09-21 from %s",
+				tt.now, s.Anchor, s.Published, s.Estimated, s.Basis, BasisNumbers)
+		}
+		if s.Next != "2025-R38" || s.Due != "2025-09-28" {
+			t.Errorf("CheckStale(%s): %s due %s, want 2025-R38 due 2025-09-28", tt.now, s.Next, s.Due)
+		}
+		if s.Stale != tt.stale || tt.stale && s.OverdueDays != tt.overdue {
+			t.Errorf("CheckStale(%s) = stale %v, %d days overdue; want %v, %d", tt.now, s.Stale, s.OverdueDays, tt.stale, tt.overdue)
+		}
+	}
+}
+
+func TestPredictCountsFromDatedRelease(t *testing.T) {
+	releases := numbered(map[int]int{2024: 50, 2025: 37})
+	for i := range releases {
+		if releases[i].Version == "2025-R35" {
+			releases[i].Published = date(t, "2025-09-10")
+		}
+	}
+	pred, err := Predict(&project.GKE, releases)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Three releases from 2025-R35 to 2025-R38, 7.3 days apart.
+	if pred.Estimated || pred.Anchor != "2025-R35" || pred.Published != "2025-09-10" || pred.Due != "2025-10-02" {
+		t.Errorf("Predict counts from %s published %s (estimated %v) to %s due %s, want 2025-R35 published 2025-09-10 to 2025-R38 due 2025-10-02",
+			pred.Anchor, pred.Published, pred.Estimated, pred.Next, pred.Due)
+	}
+}
+
+func TestPredictWithoutInterval(t *testing.T) {
+	p := project.GKE
+	versioning := *p.Versioning
+	versioning.ReleaseIntervalDays = 0
+	p.Versioning = &versioning
+	if _, err := Predict(&p, numbered(map[int]int{2025: 3})); err == nil {
+		t.Error("Predict without a declared or measurable interval succeeded")
+	}
+}
@@ -0,0 +1,211 @@
+// Package calver parses and orders calendar release versions such as the
+// "<YYYY>-R<MINOR>" releases of GKE.
+//
+// The recorded data mixes padded and unpadded minors ("2022-R9" next to
+// "2022-R02"), so versions must be compared parsed: as strings "2022-R9"
+// sorts after "2022-R10".
+package calver
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/chkk-io/schema/model"
+)
+
+// DefaultPattern is the release pattern used by Parse.
+const DefaultPattern = "<YYYY>-R<MINOR>"
+
+// minorWidth is the zero-padded width of <MINOR> in canonical form.
+const minorWidth = 2
+
+var tokenPattern = regexp.MustCompile(`<[A-Z]+>`)
+
+// Version is a calendar release: a year and a release number within it.
+type Version struct {
+	Year  int
+	Minor int
+}
+
+// String returns the canonical "<YYYY>-R<MINOR>" form, e.g. "2022-R09".
+func (v Version) String() string {
+	return defaultParser.Format(v)
+}
+
+// IsZero reports whether v is the zero Version.
+func (v Version) IsZero() bool {
+	return v == Version{}
+}
+
+// Compare returns -1, 0 or 1 depending on whether v is older than, equal to
+// or newer than o.
+func (v Version) Compare(o Version) int {
+	switch {
+	case v.Year < o.Year, v.Year == o.Year && v.Minor < o.Minor:
+		return -1
+	case v == o:
+		return 0
+	}
+	return 1
+}
+
+// Less reports whether v is older than o.
+func (v Version) Less(o Version) bool {
+	return v.Compare(o) < 0
+}
+
+// Parser parses the versions described by a list of release patterns.
+type Parser struct {
+	patterns []string
+	res      []*regexp.Regexp
+}
+
+var defaultParser = MustParser([]string{DefaultPattern})
+
+// NewParser builds a Parser from release patterns such as
+// "<YYYY>-R<MINOR>". The supported tokens are <YYYY>, <YY> and <MINOR>;
+// everything else is matched literally.
+func NewParser(patterns []string) (*Parser, error) {
+	if len(patterns) == 0 {
+		return nil, fmt.Errorf("no release patterns")
+	}
+	p := &Parser{patterns: patterns}
+	for _, pattern := range patterns {
+		var b strings.Builder
+		b.WriteString("^")
+		last := 0
+		var year, minor bool
+		for _, loc := range tokenPattern.FindAllStringIndex(pattern, -1) {
+			b.WriteString(regexp.QuoteMeta(pattern[last:loc[0]]))
+			switch token := pattern[loc[0]:loc[1]]; token {
+			case "<YYYY>":
+				b.WriteString(`(?P<year>\d{4})`)
+				year = true
+			case "<YY>":
+				b.WriteString(`(?P<yy>\d{2})`)
+				year = true
+			case "<MINOR>":
+				b.WriteString(`(?P<minor>\d+)`)
+				minor = true
+			default:
+				return nil, fmt.Errorf("release pattern %q: unsupported token %s", pattern, token)
+			}
+			last = loc[1]
+		}
+		if !year || !minor {
+			return nil, fmt.Errorf("release pattern %q: needs a year and a <MINOR> token", pattern)
+		}
+		b.WriteString(regexp.QuoteMeta(pattern[last:]))
+		b.WriteString("$")
+		p.res = append(p.res, regexp.MustCompile(b.String()))
+	}
+	return p, nil
+}
+
+// MustParser is like NewParser but panics on invalid patterns.
+func MustParser(patterns []string) *Parser {
+	p, err := NewParser(patterns)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// ForProject returns the Parser for project's declared ReleasePatterns.
+func ForProject(project *model.Project) (*Parser, error) {
+	if project.Versioning == nil || len(project.Versioning.ReleasePatterns) == 0 {
+		return nil, fmt.Errorf("project %s declares no release patterns", project.ID)
+	}
+	return NewParser(project.Versioning.ReleasePatterns)
+}
+
+// Parse parses s against the parser's patterns. Releases are numbered from
+// 1, so a <MINOR> of 0 does not match.
+func (p *Parser) Parse(s string) (Version, error) {
+	for _, re := range p.res {
+		m := re.FindStringSubmatch(s)
+		if m == nil {
+			continue
+		}
+		var v Version
+		for i, name := range re.SubexpNames() {
+			n, _ := strconv.Atoi(m[i])
+			switch name {
+			case "year":
+				v.Year = n
+			case "yy":
+				v.Year = 2000 + n
+			case "minor":
+				v.Minor = n
+			}
+		}
+		if v.Minor == 0 {
+			continue
+		}
+		return v, nil
+	}
+	return Version{}, fmt.Errorf("version %q does not match %s", s, strings.Join(p.patterns, ", "))
+}
+
+// Format renders v in the canonical form of the parser's first pattern.
+func (p *Parser) Format(v Version) string {
+	return tokenPattern.ReplaceAllStringFunc(p.patterns[0], func(token string) string {
+		switch token {
+		case "<YYYY>":
+			return fmt.Sprintf("%04d", v.Year)
+		case "<YY>":
+			return fmt.Sprintf("%02d", v.Year%100)
+		}
+		return fmt.Sprintf("%0*d", minorWidth, v.Minor)
+	})
+}
+
+// Canonical parses s and returns it in canonical form.
+func (p *Parser) Canonical(s string) (string, error) {
+	v, err := p.Parse(s)
+	if err != nil {
+		return "", err
+	}
+	return p.Format(v), nil
+}
+
+// Parse parses s against DefaultPattern.
+func Parse(s string) (Version, error) {
+	return defaultParser.Parse(s)
+}
+
+// MustParse is like Parse but panics on invalid versions.
+func MustParse(s string) Version {
+	v, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Equal reports whether a and b name the same release, e.g. "2022-R9" and
+// "2022-R09".
+func Equal(a, b string) bool {
+	va, errA := Parse(a)
+	vb, errB := Parse(b)
+	return errA == nil && errB == nil && va == vb
+}
+
+// Sort sorts vs newest first.
+func Sort(vs []Version) {
+	sort.Slice(vs, func(i, j int) bool { return vs[j].Less(vs[i]) })
+}
+
+// Max returns the newest of vs, or the zero Version when vs is empty.
+func Max(vs []Version) Version {
+	var max Version
+	for _, v := range vs {
+		if max.Less(v) {
+			max = v
+		}
+	}
+	return max
+}
@@ -0,0 +1,103 @@
+package calver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Version
+		wantErr bool
+	}{
+		{in: "2025-R37", want: Version{Year: 2025, Minor: 37}},
+		{in: "2022-R9", want: Version{Year: 2022, Minor: 9}},
+		{in: "2022-R09", want: Version{Year: 2022, Minor: 9}},
+		{in: "2025-37", wantErr: true},
+		{in: "2025-R0", wantErr: true},
+		{in: "2025-R00", wantErr: true},
+		{in: "R37", wantErr: true},
+		{in: "25-R37", wantErr: true},
+		{in: "2025-R37 ", wantErr: true},
+		{in: "2025-r37", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("Parse(%q) = %v, %v; want %v, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestParserPatterns(t *testing.T) {
+	p, err := NewParser([]string{"<YYYY>-R<MINOR>", "<YY>.<MINOR>"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for in, want := range map[string]Version{
+		"2025-R37": {Year: 2025, Minor: 37},
+		"25.4":     {Year: 2025, Minor: 4},
+	} {
+		if got, err := p.Parse(in); err != nil || got != want {
+			t.Errorf("Parse(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+	if got := p.Format(Version{Year: 2025, Minor: 4}); got != "2025-R04" {
+		t.Errorf("Format = %q, want 2025-R04", got)
+	}
+	for _, patterns := range [][]string{nil, {"R<MINOR>"}, {"<YYYY>"}, {"<YYYY>-<PATCH>"}} {
+		if _, err := NewParser(patterns); err == nil {
+			t.Errorf("NewParser(%q) succeeded, want an error", patterns)
+		}
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	for in, want := range map[string]string{"2022-R9": "2022-R09", "2025-R37": "2025-R37", "2025-R100": "2025-R100"} {
+		if got, err := defaultParser.Canonical(in); err != nil || got != want {
+			t.Errorf("Canonical(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if !Equal("2022-R9", "2022-R09") || Equal("2022-R9", "2022-R90") || Equal("2022-R9", "R9") {
+		t.Error("Equal compares unparsed or differently numbered releases as equal")
+	}
+}
+
+func TestOrder(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"2025-R37", "2025-R37", 0},
+		{"2022-R9", "2022-R10", -1},
+		{"2022-R10", "2022-R9", 1},
+		// Across a year boundary the year decides, not the number.
+		{"2024-R50", "2025-R01", -1},
+		{"2025-R1", "2024-R50", 1},
+		{"2023-R26", "2024-R02", -1},
+	}
+	for _, tt := range tests {
+		a, b := MustParse(tt.a), MustParse(tt.b)
+		if got := a.Compare(b); got != tt.want {
+			t.Errorf("%s.Compare(%s) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := a.Less(b); got != (tt.want < 0) {
+			t.Errorf("%s.Less(%s) = %v, want %v", tt.a, tt.b, got, tt.want < 0)
+		}
+	}
+
+	vs := []Version{MustParse("2024-R50"), MustParse("2025-R2"), MustParse("2022-R9"), MustParse("2025-R10"), MustParse("2022-R10")}
+	Sort(vs)
+	want := []Version{MustParse("2025-R10"), MustParse("2025-R2"), MustParse("2024-R50"), MustParse("2022-R10"), MustParse("2022-R9")}
+	if !reflect.DeepEqual(vs, want) {
+		t.Errorf("Sort = %v, want %v", vs, want)
+	}
+	if got := Max(vs); got != want[0] {
+		t.Errorf("Max = %v, want %v", got, want[0])
+	}
+	if got := Max(nil); !got.IsZero() {
+		t.Errorf("Max(nil) = %v, want the zero Version", got)
+	}
+}
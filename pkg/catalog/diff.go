@@ -0,0 +1,123 @@
+package catalog
+
+import (
+	"fmt"
+
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// Diff is what changed in the Kubernetes versions listed between two
+// releases. Version lists hold kube@x.y.z references and minor lists
+// kube@x.y references, ascending.
+type Diff struct {
+	From      string   `json:"from"`
+	To        string   `json:"to"`
+	Channel   string   `json:"channel,omitempty"`
+	Added     []string `json:"added"`
+	Removed   []string `json:"removed"`
+	Unchanged []string `json:"unchanged"`
+	// MinorsAdded holds the minors To supports and From does not.
+	MinorsAdded []string `json:"minorsAdded"`
+	// MinorsRemoved holds the minors From supports and To does not.
+	MinorsRemoved []string `json:"minorsRemoved"`
+	// FromDefault and ToDefault are the default versions, or "" when the
+	// release has no build details recorded.
+	FromDefault string `json:"fromDefault,omitempty"`
+	ToDefault   string `json:"toDefault,omitempty"`
+	// DefaultMoved is only meaningful when DefaultKnown is set.
+	DefaultKnown bool `json:"defaultKnown"`
+	DefaultMoved bool `json:"defaultMoved"`
+}
+
+// DiffReleases compares the versions two releases offer. Versions To lists
+// as no longer available are removed, never added, and count as removed
+// even when From does not list them. It fails when either release has no
+// recorded versions, which would report every version as added or removed.
+func DiffReleases(from, to *Listing) (*Diff, error) {
+	for _, l := range []*Listing{from, to} {
+		if l.Empty() {
+			return nil, fmt.Errorf("%s has no recorded versions", l.Release)
+		}
+	}
+	var added, removed, unchanged []kubever.Version
+	for v := range to.Offered {
+		if from.Offered[v] {
+			unchanged = append(unchanged, v)
+		} else {
+			added = append(added, v)
+		}
+	}
+	for v := range from.Offered {
+		if !to.Offered[v] {
+			removed = append(removed, v)
+		}
+	}
+	for v := range to.Removed {
+		if !from.Offered[v] {
+			removed = append(removed, v)
+		}
+	}
+	fromMinors, toMinors := minors(from.Offered), minors(to.Offered)
+	var minorsAdded, minorsRemoved []kubever.Minor
+	for m := range toMinors {
+		if !fromMinors[m] {
+			minorsAdded = append(minorsAdded, m)
+		}
+	}
+	for m := range fromMinors {
+		if !toMinors[m] {
+			minorsRemoved = append(minorsRemoved, m)
+		}
+	}
+	return &Diff{
+		From:          from.Release,
+		To:            to.Release,
+		Added:         kubever.SortedRefs(added),
+		Removed:       kubever.SortedRefs(removed),
+		Unchanged:     kubever.SortedRefs(unchanged),
+		MinorsAdded:   kubever.MinorRefs(minorsAdded),
+		MinorsRemoved: kubever.MinorRefs(minorsRemoved),
+	}, nil
+}
+
+// GKEDiff compares two GKE releases of channel, e.g. 2025-R20 and 2025-R37,
+// using the roles of their builds where GKEReleaseDetails records them.
+// Each default is taken from the builds recorded for its release.
+func GKEDiff(from, to string, channel project.ReleaseChannel) (*Diff, error) {
+	if channel == "" {
+		channel = project.DefaultChannel
+	}
+	fromListing, err := GKEListing(from, channel)
+	if err != nil {
+		return nil, err
+	}
+	toListing, err := GKEListing(to, channel)
+	if err != nil {
+		return nil, err
+	}
+	d, err := DiffReleases(fromListing, toListing)
+	if err != nil {
+		return nil, err
+	}
+	d.Channel = string(channel)
+	if c, err := project.GKEReleaseChanges(from, channel); err == nil {
+		d.FromDefault = c.Default
+	}
+	if c, err := project.GKEReleaseChanges(to, channel); err == nil {
+		d.ToDefault = c.Default
+	}
+	if d.FromDefault != "" && d.ToDefault != "" {
+		d.DefaultKnown = true
+		d.DefaultMoved = d.FromDefault != d.ToDefault
+	}
+	return d, nil
+}
+
+func minors(versions map[kubever.Version]bool) map[kubever.Minor]bool {
+	set := map[kubever.Minor]bool{}
+	for v := range versions {
+		set[v.Line()] = true
+	}
+	return set
+}
@@ -0,0 +1,63 @@
+package catalog
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+func listing(t *testing.T, version string, builds []project.ReleaseBuild, refs ...string) *Listing {
+	t.Helper()
+	r := release(version, refs...)
+	l, err := NewListing(&r, &project.ReleaseDetail{Version: version, Builds: builds}, project.ChannelStable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
+
+func TestDiffReleases(t *testing.T) {
+	from := listing(t, "2025-R20", nil, "kube@1.30.12", "kube@1.31.8", "kube@1.32.4")
+	to := listing(t, "2025-R37", []project.ReleaseBuild{
+		{Channel: project.ChannelStable, Role: project.RoleRemoved, Version: "1.30.12-gke.100"},
+		{Channel: project.ChannelStable, Role: project.RoleRemoved, Version: "1.29.15-gke.100"},
+		{Channel: project.ChannelStable, Role: project.RoleDefault, Version: "1.32.4-gke.100"},
+		{Channel: project.ChannelStable, Role: project.RoleAvailable, Version: "1.33.3-gke.100"},
+	})
+	got, err := DiffReleases(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Diff{
+		From:          "2025-R20",
+		To:            "2025-R37",
+		Added:         []string{"kube@1.33.3"},
+		Removed:       []string{"kube@1.29.15", "kube@1.30.12", "kube@1.31.8"},
+		Unchanged:     []string{"kube@1.32.4"},
+		MinorsAdded:   []string{"kube@1.33"},
+		MinorsRemoved: []string{"kube@1.30", "kube@1.31"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffReleases = %+v\nwant %+v", got, want)
+	}
+}
+
+func TestDiffReleasesWithoutVersions(t *testing.T) {
+	empty := listing(t, "2025-R18", nil)
+	full := listing(t, "2025-R37", nil, "kube@1.32.6")
+	for _, pair := range [][2]*Listing{{empty, full}, {full, empty}} {
+		d, err := DiffReleases(pair[0], pair[1])
+		if err == nil || !strings.Contains(err.Error(), "2025-R18 has no recorded versions") {
+			t.Errorf("DiffReleases(%s, %s) = %+v, %v; want a no recorded versions error", pair[0].Release, pair[1].Release, d, err)
+		}
+	}
+}
+
+func TestGKEDiffWithoutVersions(t *testing.T) {
+	// 2025-R18 is recorded on Stable without any versions.
+	if d, err := GKEDiff("2025-R18", "2025-R37", project.ChannelStable); err == nil || !strings.Contains(err.Error(), "no recorded versions") {
+		t.Errorf("GKEDiff(2025-R18, 2025-R37) = %+v, %v; want a no recorded versions error", d, err)
+	}
+}
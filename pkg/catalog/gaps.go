@@ -0,0 +1,75 @@
+package catalog
+
+import (
+	"sort"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// YearGaps lists the release numbers missing in one year, oldest first in
+// canonical form, e.g. "2025-R21".
+type YearGaps struct {
+	Year    int      `json:"year"`
+	Missing []string `json:"missing"`
+}
+
+// MissingReleases returns the release numbers absent from releases between
+// the oldest and the newest recorded one, per year, oldest year first. A
+// year is assumed to count from R1 up to its highest recorded number, so
+// releases missing after that number cannot be detected here.
+func MissingReleases(p *model.Project, releases []model.ProjectRelease) ([]YearGaps, error) {
+	parser, err := calver.ForProject(p)
+	if err != nil {
+		return nil, err
+	}
+	recorded := map[calver.Version]bool{}
+	highest := map[int]int{}
+	var oldest calver.Version
+	for _, r := range releases {
+		v, err := parser.Parse(r.Version)
+		if err != nil {
+			return nil, err
+		}
+		recorded[v] = true
+		highest[v.Year] = max(highest[v.Year], v.Minor)
+		if oldest.IsZero() || v.Less(oldest) {
+			oldest = v
+		}
+	}
+	years := make([]int, 0, len(highest))
+	for y := range highest {
+		years = append(years, y)
+	}
+	sort.Ints(years)
+
+	var gaps []YearGaps
+	for _, y := range years {
+		first := 1
+		if y == oldest.Year {
+			first = oldest.Minor
+		}
+		g := YearGaps{Year: y}
+		for n := first; n <= highest[y]; n++ {
+			if v := (calver.Version{Year: y, Minor: n}); !recorded[v] {
+				g.Missing = append(g.Missing, parser.Format(v))
+			}
+		}
+		if len(g.Missing) > 0 {
+			gaps = append(gaps, g)
+		}
+	}
+	return gaps, nil
+}
+
+// GKEMissingReleases returns the release numbers missing from the GKE
+// releases of channel; see MissingReleases.
+func GKEMissingReleases(channel project.ReleaseChannel) ([]YearGaps, error) {
+	releases, err := project.GKEReleases(channel)
+	if err != nil {
+		return nil, err
+	}
+	return MissingReleases(&project.GKE, releases)
+}
@@ -0,0 +1,422 @@
+// Package catalog answers questions about recorded project releases, such as
+// which GKE R releases listed a Kubernetes version.
+package catalog
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// entry is one release of an Index with its parsed kube references.
+type entry struct {
+	release string
+	version calver.Version
+	kube    map[kubever.Version]bool
+	// published is the date the release was published, if known.
+	published time.Time
+	// listing splits the versions of the release by role, when builds
+	// with roles are recorded for it.
+	listing *Listing
+}
+
+// carryWindow is how long after the last release listing it Available
+// carries forward a version listed without roles. Releases only mention the
+// versions they change, and a version still offered has gone unmentioned by
+// up to nine weekly releases in a row.
+const carryWindow = 70 * 24 * time.Hour
+
+// Index maps the Kubernetes versions of a project's releases back to the
+// releases that list them.
+type Index struct {
+	// entries holds the releases oldest first.
+	entries []entry
+	seen    map[kubever.Version][]int
+	parser  *calver.Parser
+	// intervalDays is the declared ReleaseIntervalDays of the project.
+	intervalDays int
+}
+
+// NewIndex builds an Index of releases, which must be valid releases of
+// project. References other than kube@x.y.z are ignored.
+func NewIndex(p *model.Project, releases []model.ProjectRelease) (*Index, error) {
+	parser, err := calver.ForProject(p)
+	if err != nil {
+		return nil, err
+	}
+	x := &Index{seen: map[kubever.Version][]int{}, parser: parser}
+	if p.Versioning != nil {
+		x.intervalDays = p.Versioning.ReleaseIntervalDays
+	}
+	for _, r := range releases {
+		v, err := parser.Parse(r.Version)
+		if err != nil {
+			return nil, err
+		}
+		e := entry{release: r.Version, version: v, kube: map[kubever.Version]bool{}}
+		for _, ref := range r.RelatedProjectReleases {
+			if kv, err := kubever.ParseRef(ref); err == nil {
+				e.kube[kv] = true
+			}
+		}
+		x.entries = append(x.entries, e)
+	}
+	sort.SliceStable(x.entries, func(i, j int) bool { return x.entries[i].version.Less(x.entries[j].version) })
+	for i, e := range x.entries {
+		for kv := range e.kube {
+			x.seen[kv] = append(x.seen[kv], i)
+		}
+	}
+	return x, nil
+}
+
+// GKEIndex returns the Index of the GKE releases of channel, with the
+// publication dates and build roles recorded in project.GKEReleaseDetails.
+func GKEIndex(channel project.ReleaseChannel) (*Index, error) {
+	releases, err := project.GKEReleases(channel)
+	if err != nil {
+		return nil, err
+	}
+	x, err := NewIndex(&project.GKE, releases)
+	if err != nil {
+		return nil, err
+	}
+	for i := range project.GKEReleaseDetails {
+		if err := x.SetDetail(&project.GKEReleaseDetails[i], channel); err != nil {
+			return nil, fmt.Errorf("GKE release %s: %w", project.GKEReleaseDetails[i].Version, err)
+		}
+	}
+	return x, nil
+}
+
+// SetDetail records the publication date of the release of d and the roles
+// of its builds for channel. Releases not in the index are ignored.
+func (x *Index) SetDetail(d *project.ReleaseDetail, channel project.ReleaseChannel) error {
+	published, err := d.PublishedOn()
+	if err != nil {
+		return err
+	}
+	if !published.IsZero() {
+		x.SetPublished(d.Version, published)
+	}
+	i, ok := x.find(d.Version)
+	if !ok {
+		return nil
+	}
+	l, err := NewListing(&model.ProjectRelease{Version: x.entries[i].release}, d, channel)
+	if err != nil {
+		return err
+	}
+	if l.Roles {
+		x.entries[i].listing = l
+	}
+	return nil
+}
+
+// find returns the position of release in the index.
+func (x *Index) find(release string) (int, bool) {
+	v, err := x.parser.Parse(release)
+	if err != nil {
+		return 0, false
+	}
+	for i := range x.entries {
+		if x.entries[i].version == v {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Available returns the versions offered as of release, including those
+// Availability cannot confirm, which callers checking versions must not
+// report as withdrawn.
+func (x *Index) Available(release string) (map[kubever.Version]bool, error) {
+	offered, unconfirmed, err := x.Availability(release)
+	if err != nil {
+		return nil, err
+	}
+	for v := range unconfirmed {
+		offered[v] = true
+	}
+	return offered, nil
+}
+
+// Availability returns the versions offered as of release. Releases only
+// list what they change, so versions are carried forward from earlier
+// releases: those a release lists as default or available stay offered
+// until a later one lists them as removed, and those listed without roles
+// for carryWindow after the last release listing them. When that release
+// or the target has no publication date recorded, the time between them is
+// estimated from their release numbers, and versions within carryWindow are
+// returned as unconfirmed rather than offered.
+func (x *Index) Availability(release string) (offered, unconfirmed map[kubever.Version]bool, err error) {
+	target, ok := x.find(release)
+	if !ok {
+		return nil, nil, fmt.Errorf("release %s is not recorded", release)
+	}
+	// last holds the release that last listed each version without a role.
+	offered = map[kubever.Version]bool{}
+	last := map[kubever.Version]int{}
+	for i, e := range x.entries[:target+1] {
+		if l := e.listing; l != nil {
+			for v := range l.Removed {
+				delete(offered, v)
+				delete(last, v)
+			}
+			for v := range l.Offered {
+				offered[v] = true
+				delete(last, v)
+			}
+			continue
+		}
+		for v := range e.kube {
+			if !offered[v] {
+				last[v] = i
+			}
+		}
+	}
+	dates, estimated := x.dates()
+	unconfirmed = map[kubever.Version]bool{}
+	for v, i := range last {
+		switch {
+		case i == target:
+			offered[v] = true
+		case dates[target].Sub(dates[i]) > carryWindow:
+		case estimated[i] || estimated[target]:
+			unconfirmed[v] = true
+		default:
+			offered[v] = true
+		}
+	}
+	return offered, unconfirmed, nil
+}
+
+// dates returns when each entry was published: its recorded date or, when
+// none is recorded, an estimate counting one interval per release number
+// from the start of its year. A year's interval is its length divided by
+// the highest number recorded for it; the newest year may not be over, so
+// it takes the interval of the year before, or the declared interval when
+// that year has no releases recorded.
+func (x *Index) dates() (dates []time.Time, estimated []bool) {
+	numbered := map[int]int{}
+	for _, e := range x.entries {
+		numbered[e.version.Year] = max(numbered[e.version.Year], e.version.Minor)
+	}
+	var newest int
+	if len(x.entries) > 0 {
+		newest = x.entries[len(x.entries)-1].version.Year
+	}
+	dates, estimated = make([]time.Time, len(x.entries)), make([]bool, len(x.entries))
+	for i, e := range x.entries {
+		if !e.published.IsZero() {
+			dates[i] = e.published
+			continue
+		}
+		year := e.version.Year
+		days := float64(x.intervalDays)
+		switch {
+		case year < newest:
+			days = daysInYear(year) / float64(numbered[year])
+		case numbered[year-1] > 0:
+			days = daysInYear(year-1) / float64(numbered[year-1])
+		}
+		start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		dates[i] = start.AddDate(0, 0, int(math.Round(float64(e.version.Minor-1)*days)))
+		estimated[i] = true
+	}
+	return dates, estimated
+}
+
+func daysInYear(year int) float64 {
+	return time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC).Sub(time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)).Hours() / 24
+}
+
+// SetPublished records the date release was published. Releases not in the
+// index are ignored.
+func (x *Index) SetPublished(release string, published time.Time) {
+	if i, ok := x.find(release); ok {
+		x.entries[i].published = published
+	}
+}
+
+// Occurrence is where a Kubernetes version or minor appears in an Index.
+// Releases are listed oldest first by their recorded Version.
+type Occurrence struct {
+	// Ref is the queried reference, e.g. "kube@1.30.12" or "kube@1.30".
+	Ref string
+	// Versions holds the matching kube@x.y.z references, ascending.
+	Versions  []string
+	FirstSeen string
+	LastSeen  string
+	// Releases holds every release listing a matching version.
+	Releases []string
+	// Gaps holds the releases between FirstSeen and LastSeen that list
+	// versions but none matching.
+	Gaps []string
+	// Unrecorded holds the releases between FirstSeen and LastSeen that
+	// list no versions at all, so whether they carried Ref is unknown.
+	Unrecorded []string
+	// Since is the date FirstSeen was published, in project.DateLayout, if
+	// known.
+	Since string
+	// Until is the date the release after LastSeen was published, so Ref
+	// was last offered the day before, if known. It is empty while the
+	// newest release still lists Ref, or when the release after LastSeen
+	// lists no versions.
+	Until string
+}
+
+// Lookup returns where ref, a "kube@x.y.z" version or a "kube@x.y" minor,
+// appears in the index.
+func (x *Index) Lookup(ref string) (*Occurrence, error) {
+	match, err := matcher(ref)
+	if err != nil {
+		return nil, err
+	}
+	var versions []kubever.Version
+	listed := map[int]bool{}
+	for kv, idx := range x.seen {
+		if !match(kv) {
+			continue
+		}
+		versions = append(versions, kv)
+		for _, i := range idx {
+			listed[i] = true
+		}
+	}
+	if len(listed) == 0 {
+		return nil, fmt.Errorf("%s is not listed by any release", ref)
+	}
+	first, last := len(x.entries), -1
+	for i := range listed {
+		first = min(first, i)
+		last = max(last, i)
+	}
+	o := &Occurrence{
+		Ref:       ref,
+		Versions:  kubever.SortedRefs(versions),
+		FirstSeen: x.entries[first].release,
+		LastSeen:  x.entries[last].release,
+	}
+	if p := x.entries[first].published; !p.IsZero() {
+		o.Since = p.Format(project.DateLayout)
+	}
+	if last+1 < len(x.entries) {
+		if next := x.entries[last+1]; len(next.kube) > 0 && !next.published.IsZero() {
+			o.Until = next.published.Format(project.DateLayout)
+		}
+	}
+	for i := first; i <= last; i++ {
+		e := x.entries[i]
+		switch {
+		case listed[i]:
+			o.Releases = append(o.Releases, e.release)
+		case len(e.kube) == 0:
+			o.Unrecorded = append(o.Unrecorded, e.release)
+		default:
+			o.Gaps = append(o.Gaps, e.release)
+		}
+	}
+	return o, nil
+}
+
+// AvailableBuilds returns the full builds offered as of release, oldest
+// first. Builds are only known for releases recorded with roles; a build
+// is carried forward from the release listing it until a later one lists
+// it as removed.
+func (x *Index) AvailableBuilds(release string) ([]kubever.Build, error) {
+	target, ok := x.find(release)
+	if !ok {
+		return nil, fmt.Errorf("release %s is not recorded", release)
+	}
+	var builds []kubever.Build
+	for _, e := range x.entries[:target+1] {
+		if e.listing == nil {
+			continue
+		}
+		kept := builds[:0]
+		for _, b := range builds {
+			if !containsBuild(e.listing.RemovedBuilds, b) {
+				kept = append(kept, b)
+			}
+		}
+		builds = kept
+		for _, b := range e.listing.OfferedBuilds {
+			if !containsBuild(builds, b) {
+				builds = append(builds, b)
+			}
+		}
+	}
+	sort.Slice(builds, func(i, j int) bool { return builds[i].Less(builds[j]) })
+	return builds, nil
+}
+
+// containsBuild reports whether builds holds b, compared with Build.Compare
+// since the same build may be written differently.
+func containsBuild(builds []kubever.Build, b kubever.Build) bool {
+	for _, o := range builds {
+		if o.Compare(b) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// canonical returns release in the canonical form of the index's parser,
+// e.g. "2022-R09" for a release recorded as "2022-R9".
+func (x *Index) canonical(release string) string {
+	if c, err := x.parser.Canonical(release); err == nil {
+		return c
+	}
+	return release
+}
+
+// Newest returns the newest release in the index, or "" when it is empty.
+func (x *Index) Newest() string {
+	if len(x.entries) == 0 {
+		return ""
+	}
+	return x.entries[len(x.entries)-1].release
+}
+
+// Refs returns every kube@x.y.z reference in the index, ascending.
+func (x *Index) Refs() []string {
+	versions := make([]kubever.Version, 0, len(x.seen))
+	for kv := range x.seen {
+		versions = append(versions, kv)
+	}
+	return kubever.SortedRefs(versions)
+}
+
+// Minors returns every minor in the index, ascending.
+func (x *Index) Minors() []kubever.Minor {
+	seen := map[kubever.Minor]bool{}
+	var minors []kubever.Minor
+	for kv := range x.seen {
+		if m := kv.Line(); !seen[m] {
+			seen[m] = true
+			minors = append(minors, m)
+		}
+	}
+	sort.Slice(minors, func(i, j int) bool { return minors[i].Less(minors[j]) })
+	return minors
+}
+
+// matcher returns a func reporting whether a version matches ref.
+func matcher(ref string) (func(kubever.Version) bool, error) {
+	if v, err := kubever.ParseRef(ref); err == nil {
+		return func(kv kubever.Version) bool { return kv == v }, nil
+	}
+	m, err := kubever.ParseMinorRef(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kube reference %q: want kube@x.y.z or kube@x.y", ref)
+	}
+	return func(kv kubever.Version) bool { return kv.Line() == m }, nil
+}
@@ -0,0 +1,281 @@
+package catalog
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+func release(version string, refs ...string) model.ProjectRelease {
+	return model.ProjectRelease{Project: project.GKE.ID, Version: version, RelatedProjectReleases: refs}
+}
+
+func refs(versions map[kubever.Version]bool) []string {
+	vs := make([]kubever.Version, 0, len(versions))
+	for v := range versions {
+		vs = append(vs, v)
+	}
+	return kubever.SortedRefs(vs)
+}
+
+func TestAvailable(t *testing.T) {
+	releases := []model.ProjectRelease{
+		release("2025-R05", "kube@1.31.9", "kube@1.32.7"),
+		release("2025-R04"),
+		release("2025-R03", "kube@1.30.12", "kube@1.31.9", "kube@1.32.6"),
+		release("2025-R02", "kube@1.30.12", "kube@1.31.8"),
+		release("2025-R01", "kube@1.29.4", "kube@1.30.12"),
+	}
+	x, err := NewIndex(&project.GKE, releases)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 5; i++ {
+		x.SetPublished(fmtRelease(i), time.Date(2025, 1, 7*i, 0, 0, 0, 0, time.UTC))
+	}
+	err = x.SetDetail(&project.ReleaseDetail{
+		Version: "2025-R03",
+		Builds: []project.ReleaseBuild{
+			{Channel: project.ChannelStable, Role: project.RoleRemoved, Version: "1.30.12-gke.100"},
+			{Channel: project.ChannelStable, Role: project.RoleAvailable, Version: "1.31.9-gke.100"},
+			{Channel: project.ChannelStable, Role: project.RoleDefault, Version: "1.32.6-gke.100"},
+		},
+	}, project.ChannelStable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		release string
+		want    []string
+	}{
+		{"2025-R01", []string{"kube@1.29.4", "kube@1.30.12"}},
+		// Versions left out by a release are carried forward.
+		{"2025-R02", []string{"kube@1.29.4", "kube@1.30.12", "kube@1.31.8"}},
+		// Removed versions are dropped even though the release lists them.
+		{"2025-R03", []string{"kube@1.29.4", "kube@1.31.8", "kube@1.31.9", "kube@1.32.6"}},
+		// Releases listing nothing change nothing.
+		{"2025-R04", []string{"kube@1.29.4", "kube@1.31.8", "kube@1.31.9", "kube@1.32.6"}},
+		{"2025-R5", []string{"kube@1.29.4", "kube@1.31.8", "kube@1.31.9", "kube@1.32.6", "kube@1.32.7"}},
+	}
+	for _, tt := range tests {
+		got, err := x.Available(tt.release)
+		if err != nil {
+			t.Errorf("Available(%s): %v", tt.release, err)
+			continue
+		}
+		if !reflect.DeepEqual(refs(got), tt.want) {
+			t.Errorf("Available(%s) = %v, want %v", tt.release, refs(got), tt.want)
+		}
+	}
+	if _, err := x.Available("2025-R06"); err == nil {
+		t.Error("Available of an unrecorded release succeeded")
+	}
+}
+
+func TestAvailability(t *testing.T) {
+	x, err := NewIndex(&project.GKE, []model.ProjectRelease{
+		release("2025-R05", "kube@1.33.1"),
+		release("2025-R04", "kube@1.32.6"),
+		release("2025-R03", "kube@1.31.9", "kube@1.32.6"),
+		release("2025-R02", "kube@1.31.9"),
+		release("2025-R01", "kube@1.30.12", "kube@1.31.9"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2025-R01 has no date; with no earlier year recorded it is estimated
+	// from the declared interval as published on 2025-01-01.
+	for release, date := range map[string]time.Time{
+		"2025-R02": time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC),
+		"2025-R03": time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC),
+		"2025-R04": time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+		"2025-R05": time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC),
+	} {
+		x.SetPublished(release, date)
+	}
+	err = x.SetDetail(&project.ReleaseDetail{
+		Version: "2025-R03",
+		Builds: []project.ReleaseBuild{
+			{Channel: project.ChannelStable, Role: project.RoleAvailable, Version: "1.31.9-gke.100"},
+			{Channel: project.ChannelStable, Role: project.RoleDefault, Version: "1.32.6-gke.100"},
+		},
+	}, project.ChannelStable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		release     string
+		offered     []string
+		unconfirmed []string
+	}{
+		{"2025-R01", []string{"kube@1.30.12", "kube@1.31.9"}, []string{}},
+		// 19 days after the estimated date of 2025-R01.
+		{"2025-R02", []string{"kube@1.31.9"}, []string{"kube@1.30.12"}},
+		// 78 days after it, past carryWindow.
+		{"2025-R03", []string{"kube@1.31.9", "kube@1.32.6"}, []string{}},
+		// Versions listed with roles are carried until removed, however
+		// long ago they were listed.
+		{"2025-R05", []string{"kube@1.31.9", "kube@1.32.6", "kube@1.33.1"}, []string{}},
+	}
+	for _, tt := range tests {
+		offered, unconfirmed, err := x.Availability(tt.release)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(refs(offered), tt.offered) || !reflect.DeepEqual(refs(unconfirmed), tt.unconfirmed) {
+			t.Errorf("Availability(%s) = %v, unconfirmed %v; want %v, unconfirmed %v",
+				tt.release, refs(offered), refs(unconfirmed), tt.offered, tt.unconfirmed)
+		}
+	}
+}
+
+func TestAvailabilityExpiresUnlisted(t *testing.T) {
+	x, err := NewIndex(&project.GKE, []model.ProjectRelease{
+		release("2025-R03", "kube@1.31.9"),
+		release("2025-R02", "kube@1.31.9"),
+		release("2025-R01", "kube@1.30.12", "kube@1.31.9"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x.SetPublished("2025-R01", time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC))
+	x.SetPublished("2025-R02", time.Date(2025, 3, 18, 0, 0, 0, 0, time.UTC))
+	x.SetPublished("2025-R03", time.Date(2025, 3, 19, 0, 0, 0, 0, time.UTC))
+	for release, want := range map[string][]string{
+		"2025-R02": {"kube@1.30.12", "kube@1.31.9"},
+		"2025-R03": {"kube@1.31.9"},
+	} {
+		offered, unconfirmed, err := x.Availability(release)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(refs(offered), want) || len(unconfirmed) != 0 {
+			t.Errorf("Availability(%s) = %v, unconfirmed %v; want %v", release, refs(offered), refs(unconfirmed), want)
+		}
+	}
+}
+
+func fmtRelease(minor int) string {
+	return fmt.Sprintf("2025-R%02d", minor)
+}
+
+func TestLookup(t *testing.T) {
+	stable, err := NewIndex(&project.GKE, []model.ProjectRelease{
+		release("2025-R05", "kube@1.31.9"),
+		release("2025-R04"),
+		release("2025-R03", "kube@1.31.8"),
+		release("2025-R02", "kube@1.30.12", "kube@1.31.8"),
+		release("2025-R1", "kube@1.30.12"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stable.SetPublished("2025-R01", time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC))
+	stable.SetPublished("2025-R03", time.Date(2025, 1, 21, 0, 0, 0, 0, time.UTC))
+	// Regular offers 1.31.8 before Stable does and drops it sooner.
+	regular, err := NewIndex(&project.GKE, []model.ProjectRelease{
+		release("2025-R03", "kube@1.32.1"),
+		release("2025-R02", "kube@1.31.8", "kube@1.32.1"),
+		release("2025-R01", "kube@1.31.8"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		x    *Index
+		ref  string
+		want Occurrence
+	}{
+		{
+			name: "known version",
+			x:    stable,
+			ref:  "kube@1.30.12",
+			want: Occurrence{
+				Ref:       "kube@1.30.12",
+				Versions:  []string{"kube@1.30.12"},
+				FirstSeen: "2025-R1",
+				LastSeen:  "2025-R02",
+				Releases:  []string{"2025-R1", "2025-R02"},
+				Since:     "2025-01-07",
+				Until:     "2025-01-21",
+			},
+		},
+		{
+			name: "minor with a gap and an unrecorded release",
+			x:    stable,
+			ref:  "kube@1.31",
+			want: Occurrence{
+				Ref:        "kube@1.31",
+				Versions:   []string{"kube@1.31.8", "kube@1.31.9"},
+				FirstSeen:  "2025-R02",
+				LastSeen:   "2025-R05",
+				Releases:   []string{"2025-R02", "2025-R03", "2025-R05"},
+				Unrecorded: []string{"2025-R04"},
+			},
+		},
+		{
+			name: "version on Stable",
+			x:    stable,
+			ref:  "kube@1.31.8",
+			want: Occurrence{
+				Ref:       "kube@1.31.8",
+				Versions:  []string{"kube@1.31.8"},
+				FirstSeen: "2025-R02",
+				LastSeen:  "2025-R03",
+				Releases:  []string{"2025-R02", "2025-R03"},
+			},
+		},
+		{
+			name: "same version on Regular",
+			x:    regular,
+			ref:  "kube@1.31.8",
+			want: Occurrence{
+				Ref:       "kube@1.31.8",
+				Versions:  []string{"kube@1.31.8"},
+				FirstSeen: "2025-R01",
+				LastSeen:  "2025-R02",
+				Releases:  []string{"2025-R01", "2025-R02"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		got, err := tt.x.Lookup(tt.ref)
+		if err != nil {
+			t.Errorf("%s: Lookup(%s): %v", tt.name, tt.ref, err)
+			continue
+		}
+		if !reflect.DeepEqual(*got, tt.want) {
+			t.Errorf("%s: Lookup(%s) = %+v\nwant %+v", tt.name, tt.ref, *got, tt.want)
+		}
+	}
+
+	gapped, err := NewIndex(&project.GKE, []model.ProjectRelease{
+		release("2025-R03", "kube@1.31.9"),
+		release("2025-R02", "kube@1.32.1"),
+		release("2025-R01", "kube@1.31.8"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := gapped.Lookup("kube@1.31")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Gaps, []string{"2025-R02"}) || got.Unrecorded != nil {
+		t.Errorf("Lookup(kube@1.31) gaps %v, unrecorded %v; want gap 2025-R02", got.Gaps, got.Unrecorded)
+	}
+
+	for _, ref := range []string{"kube@1.29.1", "kube@1.28", "1.30.12", "kube@latest"} {
+		if o, err := stable.Lookup(ref); err == nil {
+			t.Errorf("Lookup(%s) = %+v, want an error", ref, o)
+		}
+	}
+}
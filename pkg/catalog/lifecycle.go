@@ -0,0 +1,126 @@
+package catalog
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// Lifecycle is the life of a Kubernetes minor on GKE: when it was first
+// offered, when it left Stable and when its support ends. Dates are in
+// project.DateLayout and empty when unknown.
+type Lifecycle struct {
+	Minor string `json:"minor"`
+	// FirstRelease is the first release of any channel listing the minor.
+	// Releases are named in canonical form, e.g. "2022-R09".
+	FirstRelease   string `json:"firstRelease,omitempty"`
+	FirstPublished string `json:"firstPublished,omitempty"`
+	// LastStable is the last Stable release listing the minor, and
+	// LeftStable the date of the Stable release after it. OnStable is set
+	// while the newest Stable release still lists the minor.
+	LastStable string `json:"lastStable,omitempty"`
+	LeftStable string `json:"leftStable,omitempty"`
+	OnStable   bool   `json:"onStable"`
+	// EndOfStandardSupport and EndOfExtendedSupport are the curated dates
+	// of project.GKEMinorSupport.
+	EndOfStandardSupport string `json:"endOfStandardSupport,omitempty"`
+	EndOfExtendedSupport string `json:"endOfExtendedSupport,omitempty"`
+}
+
+// DaysUntilEOL returns the days from now until the end of standard and
+// extended support, negative once passed. ok is false for dates not
+// recorded.
+func (l *Lifecycle) DaysUntilEOL(now time.Time) (standard, extended int, standardOK, extendedOK bool) {
+	standard, standardOK = daysUntil(l.EndOfStandardSupport, now)
+	extended, extendedOK = daysUntil(l.EndOfExtendedSupport, now)
+	return standard, extended, standardOK, extendedOK
+}
+
+func daysUntil(date string, now time.Time) (int, bool) {
+	t, err := time.Parse(project.DateLayout, date)
+	if err != nil {
+		return 0, false
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return int(math.Round(t.Sub(today).Hours() / 24)), true
+}
+
+// GKELifecycles returns the lifecycle of every minor listed by a GKE
+// channel or given support dates, newest minor first.
+func GKELifecycles() ([]Lifecycle, error) {
+	indexes := map[project.ReleaseChannel]*Index{}
+	minors := map[kubever.Minor]bool{}
+	for _, c := range project.ReleaseChannels {
+		x, err := GKEIndex(c)
+		if err != nil {
+			return nil, err
+		}
+		indexes[c] = x
+		for _, m := range x.Minors() {
+			minors[m] = true
+		}
+	}
+	for _, s := range project.GKEMinorSupport {
+		if m, err := kubever.ParseMinor(s.Minor); err == nil {
+			minors[m] = true
+		}
+	}
+	sorted := make([]kubever.Minor, 0, len(minors))
+	for m := range minors {
+		sorted = append(sorted, m)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[j].Less(sorted[i]) })
+
+	out := make([]Lifecycle, len(sorted))
+	for i, m := range sorted {
+		out[i] = gkeLifecycle(m, indexes)
+	}
+	return out, nil
+}
+
+// GKELifecycle returns the lifecycle of minor, e.g. "1.30" or "kube@1.30".
+func GKELifecycle(minor string) (*Lifecycle, error) {
+	m, err := parseMinor(minor)
+	if err != nil {
+		return nil, err
+	}
+	all, err := GKELifecycles()
+	if err != nil {
+		return nil, err
+	}
+	for i := range all {
+		if all[i].Minor == m.String() {
+			return &all[i], nil
+		}
+	}
+	return nil, fmt.Errorf("GKE never listed %s and no support dates are recorded for it", m)
+}
+
+func gkeLifecycle(m kubever.Minor, indexes map[project.ReleaseChannel]*Index) Lifecycle {
+	l := Lifecycle{Minor: m.String()}
+	var first calver.Version
+	for _, c := range project.ReleaseChannels {
+		o, err := indexes[c].Lookup(m.Ref())
+		if err != nil {
+			continue
+		}
+		x := indexes[c]
+		if v, err := x.parser.Parse(o.FirstSeen); err == nil && (first.IsZero() || v.Less(first)) {
+			first = v
+			l.FirstRelease, l.FirstPublished = x.parser.Format(v), o.Since
+		}
+		if c == project.ChannelStable {
+			l.LastStable, l.LeftStable = x.canonical(o.LastSeen), o.Until
+			l.OnStable = o.LastSeen == indexes[c].Newest()
+		}
+	}
+	if s, err := project.GKEMinorSupportOf(m.String()); err == nil {
+		l.EndOfStandardSupport, l.EndOfExtendedSupport = s.EndOfStandardSupport, s.EndOfExtendedSupport
+	}
+	return l
+}
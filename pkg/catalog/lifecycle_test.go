@@ -0,0 +1,109 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+func TestGKELifecycleOfIndexes(t *testing.T) {
+	index := func(releases ...model.ProjectRelease) *Index {
+		x, err := NewIndex(&project.GKE, releases)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return x
+	}
+	rapid := index(
+		release("2025-R02", "kube@1.31.1"),
+		release("2024-R9", "kube@1.30.1"),
+	)
+	rapid.SetPublished("2024-R09", time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC))
+	stable := index(
+		release("2025-R05", "kube@1.31.4"),
+		release("2025-R04", "kube@1.30.9", "kube@1.31.4"),
+		release("2025-R03", "kube@1.30.8"),
+		release("2024-R20", "kube@1.30.1"),
+	)
+	stable.SetPublished("2025-R05", time.Date(2025, 2, 4, 0, 0, 0, 0, time.UTC))
+	indexes := map[project.ReleaseChannel]*Index{
+		project.ChannelRapid:    rapid,
+		project.ChannelRegular:  index(),
+		project.ChannelStable:   stable,
+		project.ChannelExtended: index(release("2025-R05", "kube@1.30.9")),
+	}
+	tests := []struct {
+		minor string
+		want  Lifecycle
+	}{
+		// 1.30 first appeared on Rapid and left Stable after 2025-R04.
+		{"1.30", Lifecycle{Minor: "1.30", FirstRelease: "2024-R09", FirstPublished: "2024-03-12", LastStable: "2025-R04", LeftStable: "2025-02-04"}},
+		// 1.31 is listed by the newest Stable release.
+		{"1.31", Lifecycle{Minor: "1.31", FirstRelease: "2025-R02", LastStable: "2025-R05", OnStable: true}},
+		{"1.29", Lifecycle{Minor: "1.29"}},
+	}
+	for _, tt := range tests {
+		m, err := kubever.ParseMinor(tt.minor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := gkeLifecycle(m, indexes); got != tt.want {
+			t.Errorf("gkeLifecycle(%s) = %+v\nwant %+v", tt.minor, got, tt.want)
+		}
+	}
+}
+
+func TestDaysUntilEOL(t *testing.T) {
+	l := Lifecycle{Minor: "1.30", EndOfStandardSupport: "2025-09-30", EndOfExtendedSupport: "2026-07-30"}
+	tests := []struct {
+		now                time.Time
+		standard, extended int
+	}{
+		{time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), 29, 332},
+		// The time of day does not count.
+		{time.Date(2025, 9, 30, 23, 59, 0, 0, time.UTC), 0, 303},
+		{time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC), -93, 210},
+	}
+	for _, tt := range tests {
+		standard, extended, standardOK, extendedOK := l.DaysUntilEOL(tt.now)
+		if standard != tt.standard || extended != tt.extended || !standardOK || !extendedOK {
+			t.Errorf("DaysUntilEOL(%s) = %d, %d, %v, %v; want %d, %d, true, true",
+				tt.now, standard, extended, standardOK, extendedOK, tt.standard, tt.extended)
+		}
+	}
+	l.EndOfExtendedSupport = ""
+	if _, _, standardOK, extendedOK := l.DaysUntilEOL(tests[0].now); !standardOK || extendedOK {
+		t.Errorf("DaysUntilEOL without an extended date = %v, %v; want true, false", standardOK, extendedOK)
+	}
+}
+
+func TestGKELifecycle(t *testing.T) {
+	// The recorded dates are left to gke-sync -schedule; these stand in for
+	// them.
+	saved := project.GKEMinorSupport
+	t.Cleanup(func() { project.GKEMinorSupport = saved })
+	project.GKEMinorSupport = []project.MinorSupport{{
+		Minor:                "1.30",
+		EndOfStandardSupport: "2025-09-30",
+		EndOfExtendedSupport: "2026-07-30",
+		Source:               &project.Provenance{URL: project.GKEReleaseScheduleURL},
+	}}
+	l, err := GKELifecycle("kube@1.30")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.FirstRelease == "" || l.LastStable == "" {
+		t.Errorf("GKELifecycle(1.30) = %+v, want the releases listing it", l)
+	}
+	standard, _, ok, _ := l.DaysUntilEOL(time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC))
+	if !ok || standard != 29 {
+		t.Errorf("GKELifecycle(1.30) ends standard support in %d days (known %v), want 29", standard, ok)
+	}
+	if _, err := GKELifecycle("1.99"); err == nil {
+		t.Error("GKELifecycle of a minor never listed succeeded")
+	}
+}
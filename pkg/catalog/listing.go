@@ -0,0 +1,87 @@
+package catalog
+
+import (
+	"fmt"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// Listing is what one release lists for a channel, split by role.
+// RelatedProjectReleases records every version a release mentions,
+// including the ones it lists as no longer available, so only the roles of
+// the builds in project.GKEReleaseDetails tell the two apart.
+type Listing struct {
+	Release string
+	// Offered holds the versions listed as default, available or without
+	// a role.
+	Offered map[kubever.Version]bool
+	// Removed holds the versions listed as no longer available. A version
+	// is removed only when no build of it is offered by the same release.
+	Removed map[kubever.Version]bool
+	// Roles reports whether builds with roles were recorded. Without them
+	// every listed version is in Offered.
+	Roles bool
+	// OfferedBuilds and RemovedBuilds hold the full builds behind Offered
+	// and Removed when Roles is set.
+	OfferedBuilds []kubever.Build
+	RemovedBuilds []kubever.Build
+}
+
+// NewListing returns the listing of r for channel, using the roles of the
+// builds d records for channel. d may be nil.
+func NewListing(r *model.ProjectRelease, d *project.ReleaseDetail, channel project.ReleaseChannel) (*Listing, error) {
+	l := &Listing{Release: r.Version, Offered: map[kubever.Version]bool{}, Removed: map[kubever.Version]bool{}}
+	var builds []project.ReleaseBuild
+	if d != nil {
+		builds = d.ChannelBuilds(channel)
+	}
+	if len(builds) == 0 {
+		for _, ref := range r.RelatedProjectReleases {
+			v, err := kubever.ParseRef(ref)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", r.Version, err)
+			}
+			l.Offered[v] = true
+		}
+		return l, nil
+	}
+	l.Roles = true
+	for _, b := range builds {
+		build, err := kubever.ParseBuild(b.Version)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", r.Version, err)
+		}
+		if b.Role == project.RoleRemoved {
+			l.Removed[build.Kube] = true
+			l.RemovedBuilds = append(l.RemovedBuilds, build)
+		} else {
+			l.Offered[build.Kube] = true
+			l.OfferedBuilds = append(l.OfferedBuilds, build)
+		}
+	}
+	for v := range l.Offered {
+		delete(l.Removed, v)
+	}
+	return l, nil
+}
+
+// Empty reports whether the release lists no versions at all.
+func (l *Listing) Empty() bool {
+	return len(l.Offered) == 0 && len(l.Removed) == 0
+}
+
+// GKEListing returns the listing of the GKE release of channel.
+func GKEListing(release string, channel project.ReleaseChannel) (*Listing, error) {
+	if channel == "" {
+		channel = project.DefaultChannel
+	}
+	r, err := project.GKERelease(release, channel)
+	if err != nil {
+		return nil, err
+	}
+	d, _ := project.GKEReleaseDetail(release)
+	return NewListing(r, d, channel)
+}
@@ -0,0 +1,78 @@
+package catalog
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// Offering is what a channel offered on a date: the versions of the newest
+// release published by then.
+type Offering struct {
+	Date      string `json:"date"`
+	Release   string `json:"release"`
+	Published string `json:"published"`
+	// Estimated is set when Release was picked by a publication date
+	// estimated from release numbers, its own or that of the release after
+	// it, so a neighbouring release may have been current instead.
+	Estimated bool     `json:"estimated,omitempty"`
+	Versions  []string `json:"versions"`
+	// Unconfirmed holds the versions an earlier release listed within
+	// carryWindow by estimated dates; see Index.Availability.
+	Unconfirmed []string `json:"unconfirmed,omitempty"`
+}
+
+// OfferedOn returns the versions offered on date: those of Availability as
+// of the newest release published on or before it. Releases without a
+// publication date recorded are placed by the date estimated from their
+// release number.
+func (x *Index) OfferedOn(date time.Time) (*Offering, error) {
+	day := date.Format(project.DateLayout)
+	dates, estimated := x.dates()
+	found := -1
+	for i, d := range dates {
+		if !d.After(date) && (found < 0 || !d.Before(dates[found])) {
+			found = i
+		}
+	}
+	if found < 0 {
+		return nil, fmt.Errorf("no release is recorded as published by %s", day)
+	}
+	e := x.entries[found]
+	offered, unconfirmed, err := x.Availability(e.release)
+	if err != nil {
+		return nil, err
+	}
+	if len(offered) == 0 && len(unconfirmed) == 0 {
+		return nil, fmt.Errorf("cannot tell what was offered on %s: no versions are recorded as of %s", day, e.release)
+	}
+	return &Offering{
+		Date:        day,
+		Release:     e.release,
+		Published:   dates[found].Format(project.DateLayout),
+		Estimated:   estimated[found] || (found+1 < len(x.entries) && estimated[found+1]),
+		Versions:    sortedRefs(offered),
+		Unconfirmed: sortedRefs(unconfirmed),
+	}, nil
+}
+
+// sortedRefs returns the kube@x.y.z references of versions, ascending.
+func sortedRefs(versions map[kubever.Version]bool) []string {
+	vs := make([]kubever.Version, 0, len(versions))
+	for v := range versions {
+		vs = append(vs, v)
+	}
+	return kubever.SortedRefs(vs)
+}
+
+// GKEOfferedOn returns the versions the GKE channel offered on date; see
+// Index.OfferedOn.
+func GKEOfferedOn(channel project.ReleaseChannel, date time.Time) (*Offering, error) {
+	x, err := GKEIndex(channel)
+	if err != nil {
+		return nil, err
+	}
+	return x.OfferedOn(date)
+}
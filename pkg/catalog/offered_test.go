@@ -0,0 +1,82 @@
+package catalog
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+func TestOfferedOn(t *testing.T) {
+	x, err := NewIndex(&project.GKE, []model.ProjectRelease{
+		release("2024-R50", "kube@1.31.4"),
+		release("2025-R03", "kube@1.31.5", "kube@1.32.1"),
+		release("2025-R02", "kube@1.31.5"),
+		release("2025-R01", "kube@1.30.9", "kube@1.31.4"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x.SetPublished("2025-R01", time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC))
+	x.SetPublished("2025-R02", time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC))
+	// 2025-R03 has no date; 2024 numbered 50 releases, so it is estimated
+	// published 14.6 days into 2025.
+	tests := []struct {
+		date      time.Time
+		release   string
+		published string
+		estimated bool
+		versions  []string
+		// unconfirmed is only checked when set.
+		unconfirmed []string
+	}{
+		{time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC), "2025-R01", "2025-01-07", false, []string{"kube@1.30.9", "kube@1.31.4"}, nil},
+		{time.Date(2025, 1, 14, 0, 0, 0, 0, time.UTC), "2025-R02", "2025-01-14", true, []string{"kube@1.30.9", "kube@1.31.4", "kube@1.31.5"}, nil},
+		{time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), "2025-R03", "2025-01-16", true, []string{"kube@1.31.5", "kube@1.32.1"}, []string{"kube@1.30.9", "kube@1.31.4"}},
+	}
+	for _, tt := range tests {
+		o, err := x.OfferedOn(tt.date)
+		if err != nil {
+			t.Fatalf("OfferedOn(%s): %v", tt.date.Format(project.DateLayout), err)
+		}
+		if tt.unconfirmed != nil && !reflect.DeepEqual(o.Unconfirmed, tt.unconfirmed) {
+			t.Errorf("OfferedOn(%s) leaves %v unconfirmed, want %v", tt.date.Format(project.DateLayout), o.Unconfirmed, tt.unconfirmed)
+		}
+		if o.Release != tt.release || o.Published != tt.published || o.Estimated != tt.estimated || !reflect.DeepEqual(o.Versions, tt.versions) {
+			t.Errorf("OfferedOn(%s) = %+v, want %s published %s (estimated %v) offering %v",
+				tt.date.Format(project.DateLayout), o, tt.release, tt.published, tt.estimated, tt.versions)
+		}
+	}
+	if _, err := x.OfferedOn(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("OfferedOn before any release succeeded")
+	}
+}
+
+func TestGKEOfferedOn(t *testing.T) {
+	// 2024-R06 is dated; versions last listed long before it are no longer
+	// carried forward.
+	o, err := GKEOfferedOn(project.ChannelStable, time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Release != "2024-R06" || o.Estimated {
+		t.Errorf("GKEOfferedOn(2024-03-05) = %s (estimated %v), want 2024-R06", o.Release, o.Estimated)
+	}
+	for _, ref := range append(o.Versions, o.Unconfirmed...) {
+		switch ref {
+		case "kube@1.21.14", "kube@1.22.17", "kube@1.23.17":
+			t.Errorf("GKEOfferedOn(2024-03-05) reports %s, last listed in 2023", ref)
+		}
+	}
+
+	o, err = GKEOfferedOn(project.ChannelStable, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(o.Release) < 4 || o.Release[:4] != "2025" || !o.Estimated || len(o.Versions) == 0 {
+		t.Errorf("GKEOfferedOn(2025-03-01) = %+v, want an estimated 2025 release offering versions", o)
+	}
+}
@@ -0,0 +1,156 @@
+package catalog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// Plan is a sequence of control plane upgrades from a cluster's version to
+// the newest version of a target minor, using only the versions a release
+// offers.
+type Plan struct {
+	Release string `json:"release"`
+	Channel string `json:"channel,omitempty"`
+	// From is the cluster's current kube@x.y.z version.
+	From string `json:"from"`
+	// Target is the kube@x.y minor to reach.
+	Target string `json:"target"`
+	// Steps is empty when the cluster already runs the newest version of
+	// Target.
+	Steps []Step `json:"steps"`
+}
+
+// Step is one upgrade of a Plan.
+type Step struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// NoPathError explains why no upgrade Plan exists.
+type NoPathError struct {
+	Release string
+	From    string
+	Target  string
+	Reason  string
+}
+
+func (e *NoPathError) Error() string {
+	return fmt.Sprintf("no upgrade path from %s to %s in %s: %s", e.From, e.Target, e.Release, e.Reason)
+}
+
+// PlanUpgrade plans the upgrade of a cluster running current, a kube@x.y.z
+// or x.y.z version, to target, a kube@x.y or x.y minor, with the versions
+// release offers. Versions it lists as no longer available are never used.
+//
+// The control plane upgrades one minor at a time, so every minor between
+// current and target must be offered by release; projects whose Versioning
+// sets IndividualUpgradeRecommended are also never planned past an
+// intermediate minor. Each step moves to the newest patch of its minor; a
+// patch upgrade is only planned when target is the current minor.
+func PlanUpgrade(p *model.Project, release *Listing, current, target string) (*Plan, error) {
+	from, err := parseKube(current)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseMinor(target)
+	if err != nil {
+		return nil, err
+	}
+	newest := map[kubever.Minor]kubever.Version{}
+	for v := range release.Offered {
+		if n, ok := newest[v.Line()]; !ok || n.Less(v) {
+			newest[v.Line()] = v
+		}
+	}
+
+	plan := &Plan{Release: release.Release, From: from.Ref(), Target: to.Ref(), Steps: []Step{}}
+	noPath := func(format string, args ...any) error {
+		return &NoPathError{Release: release.Release, From: plan.From, Target: plan.Target, Reason: fmt.Sprintf(format, args...)}
+	}
+	switch {
+	case release.Empty():
+		return nil, noPath("%s has no recorded versions", release.Release)
+	case len(release.Offered) == 0:
+		return nil, noPath("%s only lists versions that are no longer available", release.Release)
+	case to.Major != from.Major:
+		return nil, noPath("upgrades across major versions are not supported")
+	case to.Less(from.Line()):
+		return nil, noPath("%s is older than the current minor %s; downgrades are not supported", to.Ref(), from.Line().Ref())
+	}
+
+	if to == from.Line() {
+		n, ok := newest[to]
+		if !ok {
+			return nil, noPath("%s offers no %s version", release.Release, to.Ref())
+		}
+		if from.Less(n) {
+			plan.Steps = append(plan.Steps, Step{From: from.Ref(), To: n.Ref()})
+		}
+		return plan, nil
+	}
+	at := from
+	for m := from.Line().Next(); !to.Less(m); m = m.Next() {
+		n, ok := newest[m]
+		if !ok {
+			reason := fmt.Sprintf("%s offers no %s version and the control plane cannot skip a minor", release.Release, m.Ref())
+			if p.Versioning != nil && p.Versioning.IndividualUpgradeRecommended {
+				reason += fmt.Sprintf("; %s recommends upgrading through every minor", p.ID)
+			}
+			if offered := offeredMinors(newest); offered != "" {
+				reason += " (offered: " + offered + ")"
+			}
+			return nil, noPath("%s", reason)
+		}
+		plan.Steps = append(plan.Steps, Step{From: at.Ref(), To: n.Ref()})
+		at = n
+	}
+	return plan, nil
+}
+
+// GKEPlanUpgrade plans an upgrade with the versions the GKE release of
+// channel offers; see PlanUpgrade.
+func GKEPlanUpgrade(current, target, release string, channel project.ReleaseChannel) (*Plan, error) {
+	if channel == "" {
+		channel = project.DefaultChannel
+	}
+	l, err := GKEListing(release, channel)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := PlanUpgrade(&project.GKE, l, current, target)
+	if err != nil {
+		return nil, err
+	}
+	plan.Channel = string(channel)
+	return plan, nil
+}
+
+// offeredMinors lists the minors in newest as kube@x.y references.
+func offeredMinors(newest map[kubever.Minor]kubever.Version) string {
+	ms := make([]kubever.Minor, 0, len(newest))
+	for m := range newest {
+		ms = append(ms, m)
+	}
+	return strings.Join(kubever.MinorRefs(ms), ", ")
+}
+
+// parseKube parses a "kube@x.y.z" reference or a plain "x.y.z" version.
+func parseKube(s string) (kubever.Version, error) {
+	if strings.HasPrefix(s, kubever.RefPrefix) {
+		return kubever.ParseRef(s)
+	}
+	return kubever.Parse(s)
+}
+
+// parseMinor parses a "kube@x.y" reference or a plain "x.y" minor.
+func parseMinor(s string) (kubever.Minor, error) {
+	if strings.HasPrefix(s, kubever.RefPrefix) {
+		return kubever.ParseMinorRef(s)
+	}
+	return kubever.ParseMinor(s)
+}
@@ -0,0 +1,94 @@
+package catalog
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+func TestPlanUpgrade(t *testing.T) {
+	offered := listing(t, "2025-R37", nil, "kube@1.30.12", "kube@1.30.14", "kube@1.31.11", "kube@1.32.6", "kube@1.32.7", "kube@1.33.3")
+	tests := []struct {
+		name    string
+		current string
+		target  string
+		want    []Step
+	}{
+		{
+			name:    "multi-hop minor upgrade",
+			current: "1.30.12",
+			target:  "1.33",
+			want: []Step{
+				{From: "kube@1.30.12", To: "kube@1.31.11"},
+				{From: "kube@1.31.11", To: "kube@1.32.7"},
+				{From: "kube@1.32.7", To: "kube@1.33.3"},
+			},
+		},
+		{
+			name:    "patch upgrade within the minor",
+			current: "kube@1.32.6",
+			target:  "kube@1.32",
+			want:    []Step{{From: "kube@1.32.6", To: "kube@1.32.7"}},
+		},
+		{
+			name:    "already current",
+			current: "1.33.3",
+			target:  "1.33",
+			want:    []Step{},
+		},
+	}
+	for _, tt := range tests {
+		plan, err := PlanUpgrade(&project.GKE, offered, tt.current, tt.target)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(plan.Steps, tt.want) {
+			t.Errorf("%s: steps %+v, want %+v", tt.name, plan.Steps, tt.want)
+		}
+	}
+}
+
+func TestPlanUpgradeNoPath(t *testing.T) {
+	gapped := listing(t, "2025-R30", nil, "kube@1.30.12", "kube@1.32.6")
+	removedOnly := listing(t, "2025-R31", []project.ReleaseBuild{
+		{Channel: project.ChannelStable, Role: project.RoleRemoved, Version: "1.30.12-gke.100"},
+	})
+	tests := []struct {
+		name    string
+		release *Listing
+		current string
+		target  string
+		reason  []string
+	}{
+		{"no recorded versions", listing(t, "2025-R18", nil), "1.30.12", "1.31", []string{"2025-R18 has no recorded versions"}},
+		{"only removed versions", removedOnly, "1.30.12", "1.31", []string{"only lists versions that are no longer available"}},
+		{"skipped minor", gapped, "1.30.12", "1.32", []string{
+			"2025-R30 offers no kube@1.31 version and the control plane cannot skip a minor",
+			"recommends upgrading through every minor",
+			"(offered: kube@1.30, kube@1.32)",
+		}},
+		{"downgrade", gapped, "1.32.6", "1.30", []string{"downgrades are not supported"}},
+		{"across majors", gapped, "1.30.12", "2.0", []string{"across major versions"}},
+		{"target not offered", gapped, "1.31.2", "1.31", []string{"offers no kube@1.31 version"}},
+	}
+	for _, tt := range tests {
+		plan, err := PlanUpgrade(&project.GKE, tt.release, tt.current, tt.target)
+		var noPath *NoPathError
+		if !errors.As(err, &noPath) {
+			t.Errorf("%s: PlanUpgrade = %+v, %v; want a NoPathError", tt.name, plan, err)
+			continue
+		}
+		for _, want := range tt.reason {
+			if !strings.Contains(noPath.Reason, want) {
+				t.Errorf("%s: reason %q, want %q", tt.name, noPath.Reason, want)
+			}
+		}
+	}
+	if _, err := PlanUpgrade(&project.GKE, gapped, "1.30", "1.31"); err == nil {
+		t.Error("PlanUpgrade from a minor succeeded, want an invalid version error")
+	}
+}
@@ -0,0 +1,290 @@
+// Package check checks the Kubernetes versions used by clusters, whether
+// exported from gcloud or declared as code, against a GKE release.
+package check
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/catalog"
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// Severity is how serious a Finding is. The values are the SARIF levels.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityNote    Severity = "note"
+)
+
+// Rules reported by the checks.
+const (
+	RuleNotOffered     = "version-not-offered"
+	RuleNearingRemoval = "minor-nearing-removal"
+	RuleSkew           = "version-skew"
+)
+
+// MaxNodeSkew is how many minors node pools may run behind the control
+// plane on GKE.
+const MaxNodeSkew = 2
+
+// Finding is one problem with a version.
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	// Subject names what uses the version, e.g. a cluster and node pool.
+	Subject string `json:"subject"`
+	// Version is the version as it was found.
+	Version string `json:"version"`
+	Message string `json:"message"`
+	// Suggestion is a version to use instead, if any.
+	Suggestion string `json:"suggestion,omitempty"`
+	// File and Line locate the version in a file, if it was read from one.
+	File string `json:"file,omitempty"`
+	Line int    `json:"line,omitempty"`
+}
+
+// Catalog is the set of Kubernetes versions offered by one release.
+type Catalog struct {
+	Release string
+	Channel project.ReleaseChannel
+	offered map[kubever.Version]bool
+	newest  map[kubever.Minor]kubever.Version
+	// oldest is the oldest minor offered, the next to be removed.
+	oldest kubever.Minor
+	// builds holds the full builds offered of each version, where known.
+	builds map[kubever.Version][]kubever.Build
+}
+
+// NewCatalog returns the Catalog of the versions and full builds offered as
+// of release, such as those catalog.Index.Available and AvailableBuilds
+// return. Builds may be nil when none are recorded.
+func NewCatalog(release string, channel project.ReleaseChannel, offered map[kubever.Version]bool, builds []kubever.Build) (*Catalog, error) {
+	c := &Catalog{
+		Release: release,
+		Channel: channel,
+		offered: map[kubever.Version]bool{},
+		newest:  map[kubever.Minor]kubever.Version{},
+		builds:  map[kubever.Version][]kubever.Build{},
+	}
+	for _, b := range builds {
+		c.builds[b.Kube] = append(c.builds[b.Kube], b)
+	}
+	for _, bs := range c.builds {
+		sort.Slice(bs, func(i, j int) bool { return bs[i].Less(bs[j]) })
+	}
+	for v := range offered {
+		c.offered[v] = true
+		if n, ok := c.newest[v.Line()]; !ok || n.Less(v) {
+			c.newest[v.Line()] = v
+		}
+	}
+	if len(c.offered) == 0 {
+		return nil, fmt.Errorf("no Kubernetes versions are offered as of %s", release)
+	}
+	first := true
+	for m := range c.newest {
+		if first || m.Less(c.oldest) {
+			c.oldest, first = m, false
+		}
+	}
+	return c, nil
+}
+
+// GKECatalog returns the Catalog of the versions offered as of the GKE
+// release of channel, or of its newest release when release is empty.
+func GKECatalog(release string, channel project.ReleaseChannel) (*Catalog, error) {
+	if channel == "" {
+		channel = project.DefaultChannel
+	}
+	var r *model.ProjectRelease
+	if release == "" {
+		releases, err := project.GKEReleases(channel)
+		if err != nil {
+			return nil, err
+		}
+		if len(releases) == 0 {
+			return nil, fmt.Errorf("no GKE releases recorded for the %s channel", channel)
+		}
+		r = &releases[0]
+	} else {
+		var err error
+		if r, err = project.GKERelease(release, channel); err != nil {
+			return nil, err
+		}
+	}
+	x, err := catalog.GKEIndex(channel)
+	if err != nil {
+		return nil, err
+	}
+	offered, err := x.Available(r.Version)
+	if err != nil {
+		return nil, err
+	}
+	builds, err := x.AvailableBuilds(r.Version)
+	if err != nil {
+		return nil, err
+	}
+	return NewCatalog(r.Version, channel, offered, builds)
+}
+
+// Offers reports whether the release offers v.
+func (c *Catalog) Offers(v kubever.Version) bool {
+	return c.offered[v]
+}
+
+// OffersMinor reports whether the release offers any version of m.
+func (c *Catalog) OffersMinor(m kubever.Minor) bool {
+	_, ok := c.newest[m]
+	return ok
+}
+
+// Minors returns the minors offered, ascending.
+func (c *Catalog) Minors() []kubever.Minor {
+	ms := make([]kubever.Minor, 0, len(c.newest))
+	for m := range c.newest {
+		ms = append(ms, m)
+	}
+	sort.Slice(ms, func(i, j int) bool { return ms[i].Less(ms[j]) })
+	return ms
+}
+
+// OffersBuild reports whether the release offers the full build b. Known
+// reports whether any builds of b's version are recorded; when none are, b
+// is taken as offered.
+func (c *Catalog) OffersBuild(b kubever.Build) (offered, known bool) {
+	builds := c.builds[b.Kube]
+	for _, o := range builds {
+		if o.Compare(b) == 0 {
+			return true, true
+		}
+	}
+	return len(builds) == 0, len(builds) > 0
+}
+
+// Newest returns the newest version offered of m.
+func (c *Catalog) Newest(m kubever.Minor) (kubever.Version, bool) {
+	v, ok := c.newest[m]
+	return v, ok
+}
+
+// name returns the release and channel for messages, e.g.
+// "2025-R37 (Stable)".
+func (c *Catalog) name() string {
+	if c.Channel == "" {
+		return c.Release
+	}
+	return fmt.Sprintf("%s (%s)", c.Release, c.Channel)
+}
+
+// CheckVersion checks a full GKE version such as "1.31.9-gke.1044000" used
+// by subject. It reports versions the release does not offer, builds that
+// are not offered when the builds of their patch are recorded, and minors
+// that will be removed next.
+func (c *Catalog) CheckVersion(subject, version string) []Finding {
+	b, err := kubever.ParseBuild(version)
+	if err != nil {
+		return []Finding{{
+			Rule:     RuleNotOffered,
+			Severity: SeverityError,
+			Subject:  subject,
+			Version:  version,
+			Message:  err.Error(),
+		}}
+	}
+	return c.checkBuild(subject, version, b)
+}
+
+func (c *Catalog) checkKube(subject, version string, v kubever.Version) []Finding {
+	finding := func(rule string, severity Severity, format string, args ...any) Finding {
+		return Finding{Rule: rule, Severity: severity, Subject: subject, Version: version, Message: fmt.Sprintf(format, args...)}
+	}
+	switch {
+	case !c.OffersMinor(v.Line()):
+		return []Finding{finding(RuleNotOffered, SeverityError, "%s is no longer offered by %s, which offers %s",
+			v.Line().Ref(), c.name(), c.minorList())}
+	case !c.Offers(v):
+		n, _ := c.Newest(v.Line())
+		return []Finding{finding(RuleNotOffered, SeverityError, "%s is not offered by %s; the newest %s version is %s",
+			v.Ref(), c.name(), v.Line().Ref(), n.Ref())}
+	case v.Line() == c.oldest && len(c.newest) > 1:
+		return []Finding{finding(RuleNearingRemoval, SeverityWarning, "%s is the oldest minor offered by %s and will be removed next",
+			v.Line().Ref(), c.name())}
+	}
+	return nil
+}
+
+func (c *Catalog) minorList() string {
+	return strings.Join(kubever.MinorRefs(c.Minors()), ", ")
+}
+
+// CheckSkew checks a node pool version against its control plane version:
+// nodes may not be newer than the control plane nor more than MaxNodeSkew
+// minors behind it. Any minor skew is reported as a warning.
+func CheckSkew(subject, controlPlane, nodes string) []Finding {
+	cp, err := kubever.ParseBuild(controlPlane)
+	if err != nil {
+		return nil
+	}
+	np, err := kubever.ParseBuild(nodes)
+	if err != nil {
+		return nil
+	}
+	cpm, npm := cp.Kube.Line(), np.Kube.Line()
+	if cpm == npm {
+		return nil
+	}
+	f := Finding{Rule: RuleSkew, Subject: subject, Version: nodes}
+	switch behind := cpm.Minor - npm.Minor; {
+	case cpm.Major != npm.Major:
+		f.Severity = SeverityError
+		f.Message = fmt.Sprintf("nodes run %s and the control plane %s, across major versions", npm.Ref(), cpm.Ref())
+	case behind < 0:
+		f.Severity = SeverityError
+		f.Message = fmt.Sprintf("nodes run %s, newer than the control plane's %s", npm.Ref(), cpm.Ref())
+	case behind > MaxNodeSkew:
+		f.Severity = SeverityError
+		f.Message = fmt.Sprintf("nodes run %s, %d minors behind the control plane's %s; at most %d are supported", npm.Ref(), behind, cpm.Ref(), MaxNodeSkew)
+	default:
+		f.Severity = SeverityWarning
+		f.Message = fmt.Sprintf("nodes run %s, %d minor(s) behind the control plane's %s", npm.Ref(), behind, cpm.Ref())
+	}
+	return []Finding{f}
+}
+
+// rank orders severities from note to error.
+func (s Severity) rank() int {
+	switch s {
+	case SeverityError:
+		return 3
+	case SeverityWarning:
+		return 2
+	case SeverityNote:
+		return 1
+	}
+	return 0
+}
+
+// ParseSeverity parses "error", "warning" or "note".
+func ParseSeverity(s string) (Severity, error) {
+	if sev := Severity(s); sev.rank() > 0 {
+		return sev, nil
+	}
+	return "", fmt.Errorf("unknown severity %q", s)
+}
+
+// Failed reports whether findings hold one at least as severe as threshold.
+func Failed(findings []Finding, threshold Severity) bool {
+	for _, f := range findings {
+		if f.Severity.rank() >= threshold.rank() {
+			return true
+		}
+	}
+	return false
+}
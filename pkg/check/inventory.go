@@ -0,0 +1,160 @@
+package check
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Cluster is a GKE cluster as exported by
+// "gcloud container clusters list --format=json".
+type Cluster struct {
+	Name                 string     `json:"name"`
+	Location             string     `json:"location"`
+	CurrentMasterVersion string     `json:"currentMasterVersion"`
+	CurrentNodeVersion   string     `json:"currentNodeVersion"`
+	NodePools            []NodePool `json:"nodePools"`
+}
+
+// NodePool is a node pool of a Cluster.
+type NodePool struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// ReadClustersJSON reads the JSON output of gcloud container clusters list.
+func ReadClustersJSON(r io.Reader) ([]Cluster, error) {
+	var clusters []Cluster
+	if err := json.NewDecoder(r).Decode(&clusters); err != nil {
+		return nil, fmt.Errorf("reading clusters JSON: %w", err)
+	}
+	return clusters, nil
+}
+
+// ReadClustersCSV reads clusters from CSV with a header row, as written by
+// gcloud --format=csv(...). Header names are matched ignoring case, "_" and
+// ".", so "currentMasterVersion" and "current_master_version" both work.
+// The columns are:
+//
+//	name                   required
+//	location or zone
+//	currentMasterVersion   or masterVersion
+//	currentNodeVersion     or nodeVersion
+//	nodePools.name         or nodePool; one row per node pool
+//	nodePools.version      or nodePoolVersion
+//
+// Rows of the same cluster and location are merged.
+func ReadClustersCSV(r io.Reader) ([]Cluster, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading clusters CSV: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	col := map[string]int{}
+	for i, h := range rows[0] {
+		key := strings.NewReplacer("_", "", ".", "", " ", "").Replace(strings.ToLower(h))
+		if name, ok := csvColumns[key]; ok {
+			col[name] = i
+		}
+	}
+	if _, ok := col["name"]; !ok {
+		return nil, fmt.Errorf("reading clusters CSV: no name column")
+	}
+	get := func(row []string, name string) string {
+		if i, ok := col[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	var clusters []Cluster
+	index := map[[2]string]int{}
+	for _, row := range rows[1:] {
+		key := [2]string{get(row, "name"), get(row, "location")}
+		i, ok := index[key]
+		if !ok {
+			i = len(clusters)
+			index[key] = i
+			clusters = append(clusters, Cluster{Name: key[0], Location: key[1]})
+		}
+		c := &clusters[i]
+		if v := get(row, "master"); v != "" {
+			c.CurrentMasterVersion = v
+		}
+		if v := get(row, "node"); v != "" {
+			c.CurrentNodeVersion = v
+		}
+		if pool, v := get(row, "pool"), get(row, "poolVersion"); pool != "" || v != "" {
+			c.NodePools = append(c.NodePools, NodePool{Name: pool, Version: v})
+		}
+	}
+	return clusters, nil
+}
+
+var csvColumns = map[string]string{
+	"name":                 "name",
+	"location":             "location",
+	"zone":                 "location",
+	"currentmasterversion": "master",
+	"masterversion":        "master",
+	"currentnodeversion":   "node",
+	"nodeversion":          "node",
+	"nodepoolsname":        "pool",
+	"nodepool":             "pool",
+	"nodepoolname":         "pool",
+	"nodepoolsversion":     "poolVersion",
+	"nodepoolversion":      "poolVersion",
+}
+
+// LoadClusters reads clusters from a .csv file or, otherwise, a JSON file.
+func LoadClusters(path string) ([]Cluster, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return ReadClustersCSV(f)
+	}
+	return ReadClustersJSON(f)
+}
+
+// CheckClusters checks the control plane and node pool versions of
+// clusters. Clusters without node pools are checked with their
+// CurrentNodeVersion.
+func (c *Catalog) CheckClusters(clusters []Cluster) []Finding {
+	var findings []Finding
+	for _, cl := range clusters {
+		name := cl.Name
+		if cl.Location != "" {
+			name = cl.Location + "/" + cl.Name
+		}
+		if cl.CurrentMasterVersion != "" {
+			findings = append(findings, c.CheckVersion(name+" control plane", cl.CurrentMasterVersion)...)
+		}
+		pools := cl.NodePools
+		if len(pools) == 0 && cl.CurrentNodeVersion != "" {
+			pools = []NodePool{{Version: cl.CurrentNodeVersion}}
+		}
+		for _, p := range pools {
+			subject := name + " nodes"
+			if p.Name != "" {
+				subject = name + " node pool " + p.Name
+			}
+			if p.Version == "" {
+				continue
+			}
+			findings = append(findings, c.CheckVersion(subject, p.Version)...)
+			if cl.CurrentMasterVersion != "" {
+				findings = append(findings, CheckSkew(subject, cl.CurrentMasterVersion, p.Version)...)
+			}
+		}
+	}
+	return findings
+}
@@ -0,0 +1,42 @@
+package check
+
+import "testing"
+
+func TestCheckClustersBuilds(t *testing.T) {
+	c := buildsCatalog(t)
+	clusters := []Cluster{
+		{Name: "recorded", Location: "us-central1", CurrentMasterVersion: "1.33.3-gke.1200000",
+			NodePools: []NodePool{{Name: "default", Version: "1.33.3-gke.1136000"}}},
+		{Name: "unrecorded", Location: "us-central1", CurrentMasterVersion: "1.33.3-gke.9999999",
+			NodePools: []NodePool{{Name: "default", Version: "1.33.3-gke.1200000"}}},
+	}
+	findings := c.CheckClusters(clusters)
+	if len(findings) != 1 {
+		t.Fatalf("CheckClusters = %+v, want 1 finding", findings)
+	}
+	f := findings[0]
+	if f.Rule != RuleNotOffered || f.Subject != "us-central1/unrecorded control plane" || f.Suggestion != "1.33.3-gke.1200000" {
+		t.Errorf("CheckClusters = %+v, want %s of the unrecorded control plane suggesting 1.33.3-gke.1200000", f, RuleNotOffered)
+	}
+}
+
+func TestCheckVersion(t *testing.T) {
+	c := buildsCatalog(t)
+	tests := []struct {
+		version  string
+		findings int
+	}{
+		{"1.33.3-gke.1136000", 0},
+		{"1.33.3", 0},
+		// No builds of 1.32.7 are recorded, so any is taken as offered and
+		// only the oldest minor is reported.
+		{"1.32.7-gke.1", 1},
+		{"1.33.3-gke.9999999", 1},
+		{"1.31.1-gke.1", 1},
+	}
+	for _, tt := range tests {
+		if findings := c.CheckVersion("cluster", tt.version); len(findings) != tt.findings {
+			t.Errorf("CheckVersion(%s) = %+v, want %d finding(s)", tt.version, findings, tt.findings)
+		}
+	}
+}
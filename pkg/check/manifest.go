@@ -0,0 +1,120 @@
+package check
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// manifestFields lists the version fields of each "<group>/<kind>".
+var manifestFields = map[string][]string{
+	"container.cnrm.cloud.google.com/ContainerCluster":       {"spec.minMasterVersion", "spec.nodeVersion"},
+	"container.cnrm.cloud.google.com/ContainerNodePool":      {"spec.version"},
+	"infrastructure.cluster.x-k8s.io/GCPManagedControlPlane": {"spec.controlPlaneVersion", "spec.version"},
+}
+
+// ParseManifests returns the GKE versions set by the Config Connector
+// ContainerCluster and ContainerNodePool and Cluster API
+// GCPManagedControlPlane objects of a YAML file, which may hold several
+// documents. Other objects are ignored.
+func ParseManifests(name string, src []byte) ([]Pin, error) {
+	var pins []Pin
+	dec := yaml.NewDecoder(bytes.NewReader(src))
+	for {
+		var doc yaml.Node
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if len(doc.Content) == 0 {
+			continue
+		}
+		obj := doc.Content[0]
+		group, _, _ := strings.Cut(scalar(lookup(obj, "apiVersion")), "/")
+		kind := scalar(lookup(obj, "kind"))
+		subject := kind + "/" + scalar(lookup(obj, "metadata", "name"))
+		if ns := scalar(lookup(obj, "metadata", "namespace")); ns != "" {
+			subject = kind + "/" + ns + "/" + scalar(lookup(obj, "metadata", "name"))
+		}
+		for _, field := range manifestFields[group+"/"+kind] {
+			n := lookup(obj, strings.Split(field, ".")...)
+			if n == nil || n.Tag == "!!null" {
+				continue
+			}
+			pin := Pin{File: name, Line: n.Line, Subject: subject + " " + field}
+			if n.Kind == yaml.ScalarNode {
+				pin.Value = n.Value
+			} else {
+				pin.Expr = "a YAML " + nodeKind(n)
+			}
+			pins = append(pins, pin)
+		}
+	}
+	return pins, nil
+}
+
+// lookup returns the node at path in the mappings under n, or nil.
+func lookup(n *yaml.Node, path ...string) *yaml.Node {
+	for _, key := range path {
+		if n == nil || n.Kind != yaml.MappingNode {
+			return nil
+		}
+		var next *yaml.Node
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if n.Content[i].Value == key {
+				next = n.Content[i+1]
+				break
+			}
+		}
+		n = next
+	}
+	return n
+}
+
+// scalar returns the value of a scalar node, or "".
+func scalar(n *yaml.Node) string {
+	if n == nil || n.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return n.Value
+}
+
+func nodeKind(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.MappingNode:
+		return "mapping"
+	case yaml.SequenceNode:
+		return "sequence"
+	}
+	return "alias"
+}
+
+// ScanManifests returns the pins of the YAML files at paths. Directories
+// are walked for .yaml and .yml files.
+func ScanManifests(paths []string) ([]Pin, error) {
+	files, err := findFiles(paths, ".yaml", ".yml")
+	if err != nil {
+		return nil, err
+	}
+	var pins []Pin
+	for _, f := range files {
+		src, err := os.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		found, err := ParseManifests(f, src)
+		if err != nil {
+			return nil, err
+		}
+		pins = append(pins, found...)
+	}
+	return pins, nil
+}
@@ -0,0 +1,58 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseManifests(t *testing.T) {
+	src := `apiVersion: container.cnrm.cloud.google.com/v1beta1
+kind: ContainerCluster
+metadata:
+  name: primary
+  namespace: infra
+spec:
+  minMasterVersion: "1.32"
+  nodeVersion: 1.32.7-gke.1000
+---
+apiVersion: container.cnrm.cloud.google.com/v1beta1
+kind: ContainerNodePool
+metadata:
+  name: pool
+spec:
+  version:
+    valueFrom: cluster
+---
+apiVersion: infrastructure.cluster.x-k8s.io/v1beta1
+kind: GCPManagedControlPlane
+metadata:
+  name: capi
+spec:
+  controlPlaneVersion: v1.31.9
+  version: null
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: ignored
+spec:
+  version: "9.9"
+---
+`
+	pins, err := ParseManifests("clusters.yaml", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Pin{
+		{File: "clusters.yaml", Line: 7, Subject: "ContainerCluster/infra/primary spec.minMasterVersion", Value: "1.32"},
+		{File: "clusters.yaml", Line: 8, Subject: "ContainerCluster/infra/primary spec.nodeVersion", Value: "1.32.7-gke.1000"},
+		{File: "clusters.yaml", Line: 16, Subject: "ContainerNodePool/pool spec.version", Expr: "a YAML mapping"},
+		{File: "clusters.yaml", Line: 23, Subject: "GCPManagedControlPlane/capi spec.controlPlaneVersion", Value: "v1.31.9"},
+	}
+	if !reflect.DeepEqual(pins, want) {
+		t.Errorf("ParseManifests =\n%+v\nwant\n%+v", pins, want)
+	}
+	if _, err := ParseManifests("bad.yaml", []byte("kind: [")); err == nil {
+		t.Error("ParseManifests of invalid YAML succeeded")
+	}
+}
@@ -0,0 +1,207 @@
+package check
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+)
+
+// Rules reported for pinned versions.
+const (
+	RuleInvalidPin = "invalid-version"
+	RuleNotChecked = "version-not-checked"
+)
+
+// Pin is a version pinned in a file, such as the min_master_version of a
+// google_container_cluster.
+type Pin struct {
+	File string
+	Line int
+	// Subject names the object and field, e.g.
+	// "google_container_cluster.primary min_master_version".
+	Subject string
+	// Value is the pinned version, or "" when Expr is not a literal.
+	Value string
+	// Expr is the pinned expression when it is not a literal, e.g.
+	// "var.gke_version".
+	Expr string
+}
+
+// CheckPins checks every pin with CheckPin.
+func (c *Catalog) CheckPins(pins []Pin) []Finding {
+	var findings []Finding
+	for _, p := range pins {
+		findings = append(findings, c.CheckPin(p)...)
+	}
+	return findings
+}
+
+// CheckPin checks a pinned version. GKE accepts "latest", a minor prefix
+// such as "1.31", a patch such as "1.31.9" or a full version such as
+// "1.31.9-gke.1044000"; prefixes are offered when any offered version
+// matches them, and full versions must also be an offered build when the
+// builds of their patch are recorded. Pins that are not offered get the
+// nearest offered version of the same form as a Suggestion.
+func (c *Catalog) CheckPin(p Pin) []Finding {
+	findings := c.checkPin(p)
+	for i := range findings {
+		findings[i].File, findings[i].Line = p.File, p.Line
+	}
+	return findings
+}
+
+func (c *Catalog) checkPin(p Pin) []Finding {
+	if p.Expr != "" {
+		return []Finding{{
+			Rule:     RuleNotChecked,
+			Severity: SeverityNote,
+			Subject:  p.Subject,
+			Version:  p.Expr,
+			Message:  fmt.Sprintf("%s is not a literal version and was not checked", p.Expr),
+		}}
+	}
+	// Cluster API writes versions as "v1.31.9".
+	value := strings.TrimPrefix(strings.TrimSpace(p.Value), "v")
+	switch value {
+	case "", "-", "latest":
+		return nil
+	}
+	if m, err := kubever.ParseMinor(value); err == nil {
+		if c.OffersMinor(m) {
+			if m == c.oldest && len(c.newest) > 1 {
+				return []Finding{{
+					Rule:     RuleNearingRemoval,
+					Severity: SeverityWarning,
+					Subject:  p.Subject,
+					Version:  value,
+					Message:  fmt.Sprintf("%s is the oldest minor offered by %s and will be removed next", m.Ref(), c.name()),
+				}}
+			}
+			return nil
+		}
+		return []Finding{{
+			Rule:       RuleNotOffered,
+			Severity:   SeverityError,
+			Subject:    p.Subject,
+			Version:    value,
+			Message:    fmt.Sprintf("no version of %s is offered by %s, which offers %s", m.Ref(), c.name(), c.minorList()),
+			Suggestion: c.NearestMinor(m).String(),
+		}}
+	}
+	b, err := kubever.ParseBuild(value)
+	if err != nil {
+		return []Finding{{
+			Rule:     RuleInvalidPin,
+			Severity: SeverityError,
+			Subject:  p.Subject,
+			Version:  value,
+			Message:  err.Error(),
+		}}
+	}
+	return c.checkBuild(p.Subject, value, b)
+}
+
+// checkBuild checks version, which parses as b, with checkKube and, when
+// version is a full build whose patch has recorded builds, that it is one
+// of them. Versions that are not offered get the nearest offered one as a
+// Suggestion.
+func (c *Catalog) checkBuild(subject, version string, b kubever.Build) []Finding {
+	findings := c.checkKube(subject, version, b.Kube)
+	for i, f := range findings {
+		if f.Rule == RuleNotOffered {
+			findings[i].Suggestion = c.Nearest(b.Kube).String()
+			return findings
+		}
+	}
+	if version == b.Kube.String() {
+		return findings
+	}
+	if offered, known := c.OffersBuild(b); known && !offered {
+		builds := c.builds[b.Kube]
+		return append(findings, Finding{
+			Rule:       RuleNotOffered,
+			Severity:   SeverityError,
+			Subject:    subject,
+			Version:    version,
+			Message:    fmt.Sprintf("build %s is not offered by %s, which offers %s", version, c.name(), buildList(builds)),
+			Suggestion: builds[len(builds)-1].String(),
+		})
+	}
+	return findings
+}
+
+// buildList returns builds, which are sorted, for messages.
+func buildList(builds []kubever.Build) string {
+	names := make([]string, len(builds))
+	for i, b := range builds {
+		names[i] = b.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+// NearestMinor returns the offered minor closest to m, preferring the
+// oldest newer one, so that upgrading to it never downgrades.
+func (c *Catalog) NearestMinor(m kubever.Minor) kubever.Minor {
+	minors := c.Minors()
+	for _, o := range minors {
+		if !o.Less(m) {
+			return o
+		}
+	}
+	return minors[len(minors)-1]
+}
+
+// Nearest returns the offered version closest to v: v itself, the newest
+// offered patch of its minor or else the newest patch of NearestMinor.
+func (c *Catalog) Nearest(v kubever.Version) kubever.Version {
+	if c.Offers(v) {
+		return v
+	}
+	if n, ok := c.Newest(v.Line()); ok {
+		return n
+	}
+	n, _ := c.Newest(c.NearestMinor(v.Line()))
+	return n
+}
+
+// findFiles returns the files at paths, walking directories for files with
+// one of exts. Terraform's .terraform directories are skipped.
+func findFiles(paths []string, exts ...string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			switch {
+			case err != nil:
+				return err
+			case d.IsDir() && d.Name() == ".terraform":
+				return filepath.SkipDir
+			case d.IsDir():
+				return nil
+			}
+			for _, ext := range exts {
+				if filepath.Ext(p) == ext {
+					files = append(files, p)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,73 @@
+package check
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestWriteSARIF(t *testing.T) {
+	findings := []Finding{
+		{
+			Rule: RuleNotOffered, Severity: SeverityError, File: "infra/main.tf", Line: 12,
+			Subject: "google_container_cluster.c min_master_version", Version: "1.29",
+			Message: "no version of kube@1.29 is offered by 2025-R37 (Stable), which offers kube@1.31, kube@1.32", Suggestion: "1.31",
+		},
+		{
+			Rule: RuleNotChecked, Severity: SeverityNote, File: "infra/pools.tf",
+			Subject: "google_container_node_pool.np version", Version: "var.gke_version",
+			Message: "var.gke_version is not a literal version and was not checked",
+		},
+		{
+			Rule: RuleSkew, Severity: SeverityWarning,
+			Subject: "us-central1/prod node pool default", Version: "1.31.9-gke.1044000",
+			Message: "nodes run kube@1.31, 1 minor(s) behind the control plane's kube@1.32",
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteSARIF(&buf, "gke", findings); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.Bytes()
+	var log sarifLog
+	if err := json.Unmarshal(got, &log); err != nil {
+		t.Fatalf("WriteSARIF wrote invalid JSON: %v", err)
+	}
+	if len(log.Runs) != 1 || len(log.Runs[0].Results) != len(findings) || len(log.Runs[0].Tool.Driver.Rules) != 3 {
+		t.Errorf("WriteSARIF wrote %+v, want one run with 3 results and 3 rules", log)
+	}
+
+	golden := filepath.Join("testdata", "findings.sarif")
+	if *update {
+		if err := os.WriteFile(golden, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("WriteSARIF differs from %s; run go test -update\ngot:\n%s", golden, got)
+	}
+}
+
+func TestWriteSARIFEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteSARIF(&buf, "gke", nil); err != nil {
+		t.Fatal(err)
+	}
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatal(err)
+	}
+	// Code scanning rejects logs whose results or rules are null.
+	if len(log.Runs) != 1 || log.Runs[0].Results == nil || log.Runs[0].Tool.Driver.Rules == nil {
+		t.Errorf("WriteSARIF(nil) = %s, want one run with empty results and rules", buf.Bytes())
+	}
+}
@@ -0,0 +1,144 @@
+package check
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// terraformFields lists the version attributes of each GKE resource type.
+// Attributes of inline node_pool blocks are keyed "<type>/node_pool".
+var terraformFields = map[string][]string{
+	"google_container_cluster":           {"min_master_version", "node_version"},
+	"google_container_cluster/node_pool": {"version"},
+	"google_container_node_pool":         {"version"},
+}
+
+// ParseTerraform returns the GKE versions pinned by the resources of a .tf
+// file, parsed with hclsyntax so that any layout is read, including blocks
+// on one line. Values other than string literals are returned as Expr.
+func ParseTerraform(name string, src []byte) ([]Pin, error) {
+	file, diags := hclsyntax.ParseConfig(src, name, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	var pins []Pin
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		if block.Type != "resource" || len(block.Labels) != 2 {
+			continue
+		}
+		address := block.Labels[0] + "." + block.Labels[1]
+		pins = append(pins, terraformPins(src, []string{block.Labels[0]}, address, block.Body)...)
+	}
+	for i := range pins {
+		pins[i].File = name
+	}
+	return pins, nil
+}
+
+// terraformPins returns the pins of body and its nested blocks. stack holds
+// the resource type, then the names of the blocks enclosing body.
+func terraformPins(src []byte, stack []string, address string, body *hclsyntax.Body) []Pin {
+	var pins []Pin
+	key := strings.Join(stack, "/")
+	for _, field := range terraformFields[key] {
+		attr, ok := body.Attributes[field]
+		if !ok {
+			continue
+		}
+		subject := address + " " + field
+		if len(stack) > 1 {
+			subject = address + " " + strings.Join(stack[1:], ".") + "." + field
+		}
+		pin := Pin{Subject: subject, Line: attr.SrcRange.Start.Line}
+		if v, diags := attr.Expr.Value(nil); !diags.HasErrors() && v.Type() == cty.String && v.IsKnown() && !v.IsNull() {
+			pin.Value = v.AsString()
+		} else {
+			pin.Expr = string(attr.Expr.Range().SliceBytes(src))
+		}
+		pins = append(pins, pin)
+	}
+	for _, block := range body.Blocks {
+		pins = append(pins, terraformPins(src, append(stack[:len(stack):len(stack)], block.Type), address, block.Body)...)
+	}
+	return pins
+}
+
+// plan is the part of "terraform show -json" output read by ParsePlan.
+type plan struct {
+	ResourceChanges []struct {
+		Address string `json:"address"`
+		Type    string `json:"type"`
+		Change  struct {
+			After map[string]any `json:"after"`
+		} `json:"change"`
+	} `json:"resource_changes"`
+}
+
+// ParsePlan returns the GKE versions pinned by the planned resources of a
+// plan in "terraform show -json" format. Pins have no Line.
+func ParsePlan(name string, src []byte) ([]Pin, error) {
+	var p plan
+	if err := json.Unmarshal(src, &p); err != nil {
+		return nil, fmt.Errorf("%s: reading Terraform plan: %w", name, err)
+	}
+	var pins []Pin
+	for _, rc := range p.ResourceChanges {
+		if rc.Change.After == nil {
+			continue
+		}
+		pins = append(pins, planPins(name, rc.Address, rc.Type, rc.Change.After)...)
+		if pools, ok := rc.Change.After["node_pool"].([]any); ok {
+			for i, pool := range pools {
+				if attrs, ok := pool.(map[string]any); ok {
+					address := fmt.Sprintf("%s node_pool[%d]", rc.Address, i)
+					pins = append(pins, planPins(name, address, rc.Type+"/node_pool", attrs)...)
+				}
+			}
+		}
+	}
+	return pins, nil
+}
+
+func planPins(name, address, key string, attrs map[string]any) []Pin {
+	var pins []Pin
+	for _, field := range terraformFields[key] {
+		if v, ok := attrs[field].(string); ok && v != "" {
+			pins = append(pins, Pin{File: name, Subject: address + " " + field, Value: v})
+		}
+	}
+	return pins
+}
+
+// ScanTerraform returns the pins of the .tf files and .json plans at paths.
+// Directories are walked for .tf files.
+func ScanTerraform(paths []string) ([]Pin, error) {
+	files, err := findFiles(paths, ".tf")
+	if err != nil {
+		return nil, err
+	}
+	var pins []Pin
+	for _, f := range files {
+		src, err := os.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		var found []Pin
+		if filepath.Ext(f) == ".json" {
+			found, err = ParsePlan(f, src)
+		} else {
+			found, err = ParseTerraform(f, src)
+		}
+		if err != nil {
+			return nil, err
+		}
+		pins = append(pins, found...)
+	}
+	return pins, nil
+}
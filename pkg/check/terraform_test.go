@@ -0,0 +1,94 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+)
+
+func TestParseTerraform(t *testing.T) {
+	src := `resource "google_container_node_pool" "np" { version = "1.33.3-gke.9999999" }
+
+resource "google_container_node_pool" "legacy" {
+  node_version = "1.20.1" # not an attribute of node pools
+  version      = var.gke_version
+}
+
+resource "google_container_cluster" "c" {
+  min_master_version = "1.32"
+  node_pool { version = "1.31.11-gke.100" }
+  /* node_version = "9.9" */
+  labels = { team = "}" }
+}
+`
+	pins, err := ParseTerraform("main.tf", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Pin{
+		{File: "main.tf", Line: 1, Subject: "google_container_node_pool.np version", Value: "1.33.3-gke.9999999"},
+		{File: "main.tf", Line: 5, Subject: "google_container_node_pool.legacy version", Expr: "var.gke_version"},
+		{File: "main.tf", Line: 9, Subject: "google_container_cluster.c min_master_version", Value: "1.32"},
+		{File: "main.tf", Line: 10, Subject: "google_container_cluster.c node_pool.version", Value: "1.31.11-gke.100"},
+	}
+	if !reflect.DeepEqual(pins, want) {
+		t.Errorf("ParseTerraform =\n%+v\nwant\n%+v", pins, want)
+	}
+	if _, err := ParseTerraform("bad.tf", []byte(`resource "a" "b" {`)); err == nil {
+		t.Error("ParseTerraform of an unclosed block succeeded")
+	}
+}
+
+// buildsCatalog returns a catalog of 2025-R39 offering 1.32.7, with no
+// builds recorded, and 1.33.3 with two recorded builds.
+func buildsCatalog(t *testing.T) *Catalog {
+	t.Helper()
+	var builds []kubever.Build
+	for _, s := range []string{"1.33.3-gke.1136000", "1.33.3-gke.1200000"} {
+		b, err := kubever.ParseBuild(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		builds = append(builds, b)
+	}
+	offered := map[kubever.Version]bool{}
+	for _, s := range []string{"1.32.7", "1.33.3"} {
+		v, err := kubever.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		offered[v] = true
+	}
+	c, err := NewCatalog("2025-R39", "", offered, builds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestCheckPinBuilds(t *testing.T) {
+	c := buildsCatalog(t)
+	tests := []struct {
+		value      string
+		suggestion string
+		findings   int
+	}{
+		{"1.33.3-gke.1136000", "", 0},
+		{"1.33.3", "", 0},
+		// No builds of 1.32.7 are recorded, so any is taken as offered and
+		// only the oldest minor is reported.
+		{"1.32.7-gke.1", "", 1},
+		{"1.33.3-gke.9999999", "1.33.3-gke.1200000", 1},
+	}
+	for _, tt := range tests {
+		findings := c.CheckPin(Pin{Subject: "np version", Value: tt.value})
+		if len(findings) != tt.findings {
+			t.Errorf("CheckPin(%s) = %+v, want %d finding(s)", tt.value, findings, tt.findings)
+			continue
+		}
+		if tt.suggestion != "" && findings[0].Suggestion != tt.suggestion {
+			t.Errorf("CheckPin(%s) suggests %q, want %q", tt.value, findings[0].Suggestion, tt.suggestion)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Package eol exports the GKE catalog in the endoflife.date API format, one
+// cycle per Kubernetes minor, so tools written against endoflife.date can
+// read curated GKE data unchanged.
+//
+// Release and EOL dates come from the publication dates in
+// project.GKEReleaseDetails and the curated project.GKEMinorSupport. Where
+// no end of support is recorded, eol and extendedSupport say whether the
+// newest release of each channel still offers the minor. releaseDate,
+// latest and latestReleaseDate are left out when not recorded, as
+// endoflife.date allows.
+package eol
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+	"github.com/abdulmajid3352/codecamp/pkg/catalog"
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+// Product is the name GKE is exported under.
+const Product = "gke"
+
+// DateOrBool is an endoflife.date field holding a date, or a boolean when
+// the date is not recorded.
+type DateOrBool struct {
+	Date string
+	Bool bool
+}
+
+// MarshalJSON writes the date, or the boolean when the date is empty.
+func (d DateOrBool) MarshalJSON() ([]byte, error) {
+	if d.Date == "" {
+		return json.Marshal(d.Bool)
+	}
+	return json.Marshal(d.Date)
+}
+
+// Cycle is one release cycle in the endoflife.date format.
+type Cycle struct {
+	Cycle             string     `json:"cycle"`
+	ReleaseDate       string     `json:"releaseDate,omitempty"`
+	EOL               DateOrBool `json:"eol"`
+	ExtendedSupport   DateOrBool `json:"extendedSupport"`
+	Latest            string     `json:"latest,omitempty"`
+	LatestReleaseDate string     `json:"latestReleaseDate,omitempty"`
+}
+
+// GKECycles returns a cycle for every Kubernetes minor on GKE, newest first.
+// Latest is the newest patch of the minor any channel listed, and its
+// release date the first time one did. Without a recorded end of standard
+// support, eol is true once no channel but Extended offers the minor; without
+// a recorded end of extended support, extendedSupport is true while Extended
+// still does.
+func GKECycles() ([]Cycle, error) {
+	lifecycles, err := catalog.GKELifecycles()
+	if err != nil {
+		return nil, err
+	}
+	var indexes []*catalog.Index
+	// standard and extended hold the minors offered by the newest release
+	// of a channel other than Extended, and of Extended.
+	standard, extended := map[kubever.Minor]bool{}, map[kubever.Minor]bool{}
+	for _, c := range project.ReleaseChannels {
+		x, err := catalog.GKEIndex(c)
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, x)
+		if x.Newest() == "" {
+			continue
+		}
+		offered, err := x.Available(x.Newest())
+		if err != nil {
+			return nil, fmt.Errorf("%s channel: %w", c, err)
+		}
+		for v := range offered {
+			if c == project.ChannelExtended {
+				extended[v.Line()] = true
+			} else {
+				standard[v.Line()] = true
+			}
+		}
+	}
+	cycles := make([]Cycle, len(lifecycles))
+	for i, l := range lifecycles {
+		m, err := kubever.ParseMinor(l.Minor)
+		if err != nil {
+			return nil, err
+		}
+		c := Cycle{
+			Cycle:           l.Minor,
+			ReleaseDate:     l.FirstPublished,
+			EOL:             DateOrBool{Date: l.EndOfStandardSupport, Bool: !standard[m]},
+			ExtendedSupport: DateOrBool{Date: l.EndOfExtendedSupport, Bool: extended[m]},
+		}
+		var latest kubever.Version
+		for _, x := range indexes {
+			o, err := x.Lookup(m.Ref())
+			if err != nil {
+				continue
+			}
+			if v, err := kubever.ParseRef(o.Versions[len(o.Versions)-1]); err == nil && latest.Less(v) {
+				latest = v
+			}
+		}
+		if latest != (kubever.Version{}) {
+			c.Latest = latest.String()
+			c.LatestReleaseDate = firstPublished(indexes, latest.Ref())
+		}
+		cycles[i] = c
+	}
+	return cycles, nil
+}
+
+// GKECycle returns the cycle of minor, e.g. "1.30".
+func GKECycle(minor string) (*Cycle, error) {
+	cycles, err := GKECycles()
+	if err != nil {
+		return nil, err
+	}
+	minor = strings.TrimPrefix(minor, kubever.RefPrefix)
+	for i := range cycles {
+		if cycles[i].Cycle == minor {
+			return &cycles[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no %s cycle %s", Product, minor)
+}
+
+// firstPublished returns the publication date of the first release of any
+// index listing ref, or "" when it is not recorded.
+func firstPublished(indexes []*catalog.Index, ref string) string {
+	var first calver.Version
+	date := ""
+	for _, x := range indexes {
+		o, err := x.Lookup(ref)
+		if err != nil {
+			continue
+		}
+		if v, err := calver.Parse(o.FirstSeen); err == nil && (first.IsZero() || v.Less(first)) {
+			first, date = v, o.Since
+		}
+	}
+	return date
+}
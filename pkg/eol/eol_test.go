@@ -0,0 +1,25 @@
+package eol
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDateOrBoolJSON(t *testing.T) {
+	for _, tt := range []struct {
+		d    DateOrBool
+		want string
+	}{
+		{DateOrBool{}, `false`},
+		{DateOrBool{Bool: true}, `true`},
+		{DateOrBool{Date: "2025-10-01", Bool: true}, `"2025-10-01"`},
+	} {
+		got, err := json.Marshal(tt.d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("%+v = %s, want %s", tt.d, got, tt.want)
+		}
+	}
+}
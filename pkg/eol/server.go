@@ -0,0 +1,66 @@
+package eol
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// Handler serves cycles on the endoflife.date API paths:
+//
+//	GET /api/gke.json           every cycle
+//	GET /api/gke/<cycle>.json   one cycle, e.g. /api/gke/1.30.json
+type Handler struct {
+	cycles []Cycle
+}
+
+// NewHandler returns a Handler serving the cycles of GKECycles.
+func NewHandler() (*Handler, error) {
+	cycles, err := GKECycles()
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{cycles: cycles}, nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	path, ok := strings.CutPrefix(r.URL.Path, "/api/")
+	if !ok || !strings.HasSuffix(path, ".json") {
+		http.NotFound(w, r)
+		return
+	}
+	path = strings.TrimSuffix(path, ".json")
+	if path == Product {
+		writeJSON(w, r, h.cycles)
+		return
+	}
+	cycle, ok := strings.CutPrefix(path, Product+"/")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	for i := range h.cycles {
+		if h.cycles[i].Cycle == cycle {
+			writeJSON(w, r, h.cycles[i])
+			return
+		}
+	}
+	http.NotFound(w, r)
+}
+
+// writeJSON writes v as the response to r. The status is sent by then, so
+// an error writing the body can only be logged.
+func writeJSON(w http.ResponseWriter, r *http.Request, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		log.Printf("writing %s: %v", r.URL.Path, err)
+	}
+}
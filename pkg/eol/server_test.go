@@ -0,0 +1,68 @@
+package eol
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	h, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	get := func(path string, v any) int {
+		t.Helper()
+		resp, err := http.Get(srv.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+				t.Errorf("GET %s: Content-Type %q, want application/json", path, ct)
+			}
+			if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+				t.Errorf("GET %s: %v", path, err)
+			}
+		}
+		return resp.StatusCode
+	}
+
+	var cycles []map[string]any
+	if code := get("/api/gke.json", &cycles); code != http.StatusOK || len(cycles) == 0 {
+		t.Errorf("GET /api/gke.json = %d with %d cycles, want 200 with every cycle", code, len(cycles))
+	}
+	for _, c := range cycles {
+		// endoflife.date requires these; the others may be left out.
+		for _, field := range []string{"cycle", "eol", "extendedSupport"} {
+			if _, ok := c[field]; !ok {
+				t.Errorf("cycle %v has no %s", c["cycle"], field)
+			}
+		}
+	}
+
+	var cycle map[string]any
+	if code := get("/api/gke/1.30.json", &cycle); code != http.StatusOK || cycle["cycle"] != "1.30" {
+		t.Errorf("GET /api/gke/1.30.json = %d, %v; want 200 with cycle 1.30", code, cycle)
+	}
+
+	for _, path := range []string{"/api/gke/0.1.json", "/api/gke/1.30", "/api/eks.json", "/gke.json"} {
+		if code := get(path, nil); code != http.StatusNotFound {
+			t.Errorf("GET %s = %d, want 404", path, code)
+		}
+	}
+
+	resp, err := http.Post(srv.URL+"/api/gke.json", "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed || resp.Header.Get("Allow") != "GET, HEAD" {
+		t.Errorf("POST /api/gke.json = %d, Allow %q; want 405, GET, HEAD", resp.StatusCode, resp.Header.Get("Allow"))
+	}
+}
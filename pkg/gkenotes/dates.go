@@ -0,0 +1,62 @@
+package gkenotes
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/abdulmajid3352/codecamp/pkg/scrape"
+)
+
+// dateLayouts are the ways the release notes write dates, e.g. "October 01,
+// 2025" in headings and "October 1, 2025" or "2025-10-01" in text.
+var dateLayouts = []string{"January 2, 2006", "Jan 2, 2006", "2006-01-02"}
+
+// datePattern matches a date written in one of dateLayouts.
+const datePattern = `([A-Z][a-z]+\.? \d{1,2}, \d{4}|\d{4}-\d{2}-\d{2})`
+
+var (
+	// rolloutStartPattern finds the start of a rollout, e.g. "the rollout
+	// began on October 1, 2025".
+	rolloutStartPattern = regexp.MustCompile(`(?i)\brollout (?:begins|began|starts|started|will begin|will start)(?: on)? ` + datePattern)
+
+	// rolloutCompletePattern finds the end of a rollout in the same
+	// sentence, e.g. "the rollout began on October 1, 2025 and is expected
+	// to be complete by October 8, 2025".
+	rolloutCompletePattern = regexp.MustCompile(`(?i)\brollout\b[^.]*?\b(?:complete|completed|finish|finished|ends?)(?: by| on)? ` + datePattern)
+)
+
+// Dates is when a release was published and rolled out. Zero times are
+// unknown.
+type Dates struct {
+	Published       time.Time
+	RolloutStart    time.Time
+	RolloutComplete time.Time
+}
+
+// ExtractDates returns the dates of s. The publication date is the date
+// heading the section is filed under; rollout dates are read from sentences
+// such as "The rollout began on October 1, 2025", which most sections do not
+// have.
+func ExtractDates(s *scrape.Section) Dates {
+	d := Dates{Published: parseDate(s.Parent)}
+	text := strings.Join(strings.Fields(s.Text()), " ")
+	if m := rolloutStartPattern.FindStringSubmatch(text); m != nil {
+		d.RolloutStart = parseDate(m[1])
+	}
+	if m := rolloutCompletePattern.FindStringSubmatch(text); m != nil {
+		d.RolloutComplete = parseDate(m[1])
+	}
+	return d
+}
+
+// parseDate parses s in any of dateLayouts, or returns the zero time.
+func parseDate(s string) time.Time {
+	s = strings.TrimSpace(strings.Replace(s, ".", "", 1))
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,326 @@
+// Package gkenotes extracts release channel panels from the "(YYYY-RXX)
+// Version updates" sections of the GKE release notes.
+//
+// GKE has published channel versions in several layouts since 2022: static
+// <devsite-selector> sections, rendered role="tab"/role="tabpanel" markup and
+// plain headings or paragraphs naming each channel. Extract recognizes all of
+// them and sorts the versions of every panel into the default, newly
+// available and no longer available groups.
+//
+// ParseSchedule reads the end of support dates of each minor from the GKE
+// release schedule page.
+package gkenotes
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+	"github.com/abdulmajid3352/codecamp/pkg/scrape"
+)
+
+var (
+	// channelPattern finds the channel named by a tab or panel label, e.g.
+	// "Stable", "Stable channel" or "Default version for new clusters
+	// (Stable)".
+	channelPattern = regexp.MustCompile(`(?i)\b(rapid|regular|stable|extended|no channel)\b`)
+
+	// labelPattern matches headings and paragraphs that only introduce the
+	// versions of one channel in layouts without tab markup.
+	labelPattern = regexp.MustCompile(`(?i)^(?:.*\()?(rapid|regular|stable|extended|no channel)(?: release)?(?: channel)?\)?:?$`)
+
+	// buildPattern matches a GKE version as written in the notes, including
+	// provider suffixes such as "-gke.1044000", "-autopilot.1" or "+cos".
+	buildPattern = regexp.MustCompile(`\b\d+\.\d+\.\d+(?:-[0-9A-Za-z.]+)*(?:\+[0-9A-Za-z.\-]+)?`)
+
+	// rolePattern finds the phrases that start a group of versions. The
+	// longer "no longer available" must win over "available".
+	rolePattern = regexp.MustCompile(`(?i)\b(?:no longer available|removed|default|available)\b`)
+
+	// sentenceEnd matches the end of a sentence. The dots inside versions
+	// are not followed by a space.
+	sentenceEnd = regexp.MustCompile(`[.!?](?:\s|$)`)
+)
+
+// Panel holds the versions listed for one release channel. Versions are
+// kept as written, e.g. "1.31.9-gke.1044000", in order of appearance.
+type Panel struct {
+	Channel   project.ReleaseChannel
+	Label     string
+	Default   []string
+	Available []string
+	Removed   []string
+	Unknown   []string
+}
+
+// All returns every version in the panel regardless of group.
+func (p *Panel) All() []string {
+	var all []string
+	all = append(all, p.Default...)
+	all = append(all, p.Available...)
+	all = append(all, p.Removed...)
+	all = append(all, p.Unknown...)
+	return all
+}
+
+// Kube returns the panel's versions normalized to Kubernetes versions.
+func (p *Panel) Kube() []kubever.Version {
+	var vs []kubever.Version
+	for _, b := range p.All() {
+		vs = append(vs, kubever.Extract(b)...)
+	}
+	return vs
+}
+
+// Roles returns every version in the panel with its role.
+func (p *Panel) Roles() map[project.VersionRole][]string {
+	return map[project.VersionRole][]string{
+		project.RoleDefault:    p.Default,
+		project.RoleAvailable:  p.Available,
+		project.RoleRemoved:    p.Removed,
+		project.RoleUnlabelled: p.Unknown,
+	}
+}
+
+func (p *Panel) add(role project.VersionRole, version string) {
+	switch role {
+	case project.RoleDefault:
+		p.Default = append(p.Default, version)
+	case project.RoleAvailable:
+		p.Available = append(p.Available, version)
+	case project.RoleRemoved:
+		p.Removed = append(p.Removed, version)
+	default:
+		p.Unknown = append(p.Unknown, version)
+	}
+}
+
+// Section is the extracted content of one R release section.
+type Section struct {
+	Release string
+	Panels  []*Panel
+}
+
+// Panel returns the panel of channel, or nil.
+func (s *Section) Panel(channel project.ReleaseChannel) *Panel {
+	for _, p := range s.Panels {
+		if p.Channel == channel {
+			return p
+		}
+	}
+	return nil
+}
+
+// NoChannelTabError reports a section without a panel for Channel.
+type NoChannelTabError struct {
+	Release string
+	Channel project.ReleaseChannel
+}
+
+func (e *NoChannelTabError) Error() string {
+	return fmt.Sprintf("%s skipped: no %s tab", e.Release, e.Channel)
+}
+
+// Extract returns the channel panels of s in page order.
+func Extract(s *scrape.Section) *Section {
+	out := &Section{Release: s.Key}
+	for _, c := range findPanels(s.Body) {
+		out.Panels = append(out.Panels, c.extract())
+	}
+	if len(out.Panels) == 0 {
+		for _, c := range labelledRuns(s.Body) {
+			out.Panels = append(out.Panels, c.extract())
+		}
+	}
+	return out
+}
+
+// ExtractChannel returns the panel of channel in s. When the section has
+// none the error is a *NoChannelTabError.
+func ExtractChannel(s *scrape.Section, channel project.ReleaseChannel) (*Panel, error) {
+	if p := Extract(s).Panel(channel); p != nil {
+		return p, nil
+	}
+	return nil, &NoChannelTabError{Release: s.Key, Channel: channel}
+}
+
+// ExtractStable returns the Stable channel panel of s, reporting
+// "<R> skipped: no Stable tab" as a *NoChannelTabError.
+func ExtractStable(s *scrape.Section) (*Panel, error) {
+	return ExtractChannel(s, project.ChannelStable)
+}
+
+// candidate is the markup of one channel before its versions are grouped.
+type candidate struct {
+	channel project.ReleaseChannel
+	label   string
+	nodes   []*html.Node
+}
+
+func (c candidate) extract() *Panel {
+	p := &Panel{Channel: c.channel, Label: c.label}
+	role := project.RoleUnlabelled
+	for _, n := range c.nodes {
+		scrape.Walk(n, func(t *html.Node) {
+			if t.Type != html.TextNode {
+				return
+			}
+			role = classify(p, role, t.Data)
+		})
+	}
+	return p
+}
+
+// classify adds the versions in text to p, switching groups at every role
+// phrase, and returns the group in effect at the end of text. A version
+// that no phrase precedes within its sentence takes the first phrase that
+// follows it there, as in "Version 1.31.9-gke.1044000 is now the default".
+func classify(p *Panel, role project.VersionRole, text string) project.VersionRole {
+	cues := rolePattern.FindAllStringIndex(text, -1)
+	ends := sentenceEnd.FindAllStringIndex(text, -1)
+	start, last := 0, -1
+	for _, loc := range buildPattern.FindAllStringIndex(text, -1) {
+		for len(ends) > 0 && ends[0][1] <= loc[0] {
+			start = ends[0][1]
+			ends = ends[1:]
+		}
+		end := len(text)
+		if len(ends) > 0 {
+			end = ends[0][0]
+		}
+		for len(cues) > 0 && cues[0][0] < loc[0] {
+			role = roleOf(text[cues[0][0]:cues[0][1]])
+			last = cues[0][0]
+			cues = cues[1:]
+		}
+		got := role
+		if last < start && len(cues) > 0 && cues[0][0] < end {
+			got = roleOf(text[cues[0][0]:cues[0][1]])
+		}
+		p.add(got, strings.TrimRight(text[loc[0]:loc[1]], "."))
+	}
+	for _, c := range cues {
+		role = roleOf(text[c[0]:c[1]])
+	}
+	return role
+}
+
+func roleOf(cue string) project.VersionRole {
+	switch strings.ToLower(cue) {
+	case "default":
+		return project.RoleDefault
+	case "no longer available", "removed":
+		return project.RoleRemoved
+	}
+	return project.RoleAvailable
+}
+
+// findPanels returns the role="tabpanel" elements below nodes, labelled
+// through aria-labelledby, a tab whose aria-controls names them or their own
+// heading, and the static <section> children of a <devsite-selector>.
+func findPanels(nodes []*html.Node) []candidate {
+	tabs := map[string]string{}
+	for _, n := range nodes {
+		scrape.Walk(n, func(c *html.Node) {
+			if scrape.Attr(c, "role") == "tab" {
+				if id := scrape.Attr(c, "aria-controls"); id != "" {
+					tabs[id] = scrape.Text(c)
+				}
+			}
+		})
+	}
+	var panels []candidate
+	visit := func(c *html.Node) {
+		var label string
+		switch {
+		case scrape.Attr(c, "role") == "tabpanel":
+			label = tabs[scrape.Attr(c, "id")]
+			if label == "" {
+				if tab := scrape.FindByID(scrape.Root(c), scrape.Attr(c, "aria-labelledby")); tab != nil {
+					label = scrape.Text(tab)
+				}
+			}
+		case c.Type == html.ElementNode && c.Data == "section" &&
+			c.Parent != nil && c.Parent.Data == "devsite-selector":
+		default:
+			return
+		}
+		if label == "" {
+			if h := firstHeading(c); h != nil {
+				label = scrape.Text(h)
+			}
+		}
+		if channel := channelOf(channelPattern, label); channel != "" {
+			panels = append(panels, candidate{channel: channel, label: label, nodes: []*html.Node{c}})
+		}
+	}
+	for _, n := range nodes {
+		scrape.Walk(n, visit)
+	}
+	return panels
+}
+
+// labelledRuns handles layouts without tab markup: each channel runs from a
+// heading or paragraph that names it up to the next such label.
+func labelledRuns(nodes []*html.Node) []candidate {
+	var runs []candidate
+	var cur *candidate
+	for _, n := range nodes {
+		if label, channel := channelLabel(n); channel != "" {
+			if cur != nil {
+				runs = append(runs, *cur)
+			}
+			// The label stays part of the run: "Default version for new
+			// clusters (Stable)" starts the default group.
+			cur = &candidate{channel: channel, label: label, nodes: []*html.Node{n}}
+			continue
+		}
+		if cur != nil {
+			cur.nodes = append(cur.nodes, n)
+		}
+	}
+	if cur != nil {
+		runs = append(runs, *cur)
+	}
+	return runs
+}
+
+// channelLabel returns the text and channel of n if n is a heading,
+// paragraph or bold run that only names a release channel.
+func channelLabel(n *html.Node) (string, project.ReleaseChannel) {
+	if n.Type != html.ElementNode {
+		return "", ""
+	}
+	if scrape.HeadingLevel(n) == 0 && n.DataAtom != atom.P && n.DataAtom != atom.Strong && n.DataAtom != atom.B {
+		return "", ""
+	}
+	label := scrape.Text(n)
+	return label, channelOf(labelPattern, label)
+}
+
+// channelOf returns the normalized channel pattern finds in label.
+func channelOf(pattern *regexp.Regexp, label string) project.ReleaseChannel {
+	m := pattern.FindStringSubmatch(label)
+	if m == nil {
+		return ""
+	}
+	if c, err := project.ParseReleaseChannel(m[1]); err == nil {
+		return c
+	}
+	return project.ChannelNone
+}
+
+func firstHeading(n *html.Node) *html.Node {
+	var found *html.Node
+	scrape.Walk(n, func(c *html.Node) {
+		if found == nil && c != n && scrape.HeadingLevel(c) > 0 {
+			found = c
+		}
+	})
+	return found
+}
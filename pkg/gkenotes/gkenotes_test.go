@@ -0,0 +1,212 @@
+package gkenotes
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+	"github.com/abdulmajid3352/codecamp/pkg/scrape"
+)
+
+// sections parses the saved release notes page testdata/name with the GKE
+// curation config and returns its sections by release.
+func sections(t *testing.T, name string) map[string]*scrape.Section {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	parsed, err := scrape.Parse(f, project.GKECurationConfig.Series.Sources[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	byKey := map[string]*scrape.Section{}
+	for _, s := range parsed {
+		byKey[s.Key] = s
+	}
+	return byKey
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		release  string
+		channels []project.ReleaseChannel
+		stable   *Panel
+	}{
+		{
+			name:     "devsite-selector sections",
+			file:     "devsite_selector.html",
+			release:  "2022-R13",
+			channels: []project.ReleaseChannel{project.ChannelRapid, project.ChannelRegular, project.ChannelStable, project.ChannelNone},
+			stable: &Panel{
+				Channel:   project.ChannelStable,
+				Label:     "Stable channel",
+				Default:   []string{"1.21.12-gke.1500"},
+				Available: []string{"1.21.12-gke.2200", "1.20.15-gke.8200"},
+				Removed:   []string{"1.20.15-gke.6000"},
+			},
+		},
+		{
+			name:     "role=tab and role=tabpanel",
+			file:     "tabpanel.html",
+			release:  "2025-R39",
+			channels: []project.ReleaseChannel{project.ChannelRapid, project.ChannelRegular, project.ChannelStable, project.ChannelExtended},
+			stable: &Panel{
+				Channel:   project.ChannelStable,
+				Label:     "Stable",
+				Default:   []string{"1.32.7-gke.1079000"},
+				Available: []string{"1.32.8-gke.1134000", "1.31.12-gke.1265000"},
+				Removed:   []string{"1.30.12-gke.1279000"},
+			},
+		},
+		{
+			name:     "label-only headings",
+			file:     "labelled.html",
+			release:  "2024-R08",
+			channels: []project.ReleaseChannel{project.ChannelRapid, project.ChannelRegular, project.ChannelStable},
+			stable: &Panel{
+				Channel:   project.ChannelStable,
+				Label:     "Default version for new clusters (Stable)",
+				Default:   []string{"1.27.11-gke.1062000"},
+				Available: []string{"1.28.3-gke.1286000+cos"},
+				Removed:   []string{"1.26.13-gke.1052000"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, ok := sections(t, tt.file)[tt.release]
+			if !ok {
+				t.Fatalf("%s has no %s section", tt.file, tt.release)
+			}
+			got := Extract(s)
+			if got.Release != tt.release {
+				t.Errorf("Release = %q, want %q", got.Release, tt.release)
+			}
+			var channels []project.ReleaseChannel
+			for _, p := range got.Panels {
+				channels = append(channels, p.Channel)
+			}
+			if !reflect.DeepEqual(channels, tt.channels) {
+				t.Errorf("channels = %v, want %v", channels, tt.channels)
+			}
+			if stable := got.Panel(project.ChannelStable); !reflect.DeepEqual(stable, tt.stable) {
+				t.Errorf("Stable panel = %+v, want %+v", stable, tt.stable)
+			}
+		})
+	}
+}
+
+func TestExtractChannel(t *testing.T) {
+	tests := []struct {
+		file    string
+		release string
+		channel project.ReleaseChannel
+		want    []string
+		err     string
+	}{
+		{file: "devsite_selector.html", release: "2022-R13", channel: project.ChannelRapid, want: []string{"1.24.1-gke.1400"}},
+		{file: "devsite_selector.html", release: "2022-R12", channel: project.ChannelStable, err: "2022-R12 skipped: no Stable tab"},
+		{file: "tabpanel.html", release: "2025-R39", channel: project.ChannelExtended, want: []string{"1.30.14-gke.1150000"}},
+		{file: "tabpanel.html", release: "2025-R38", channel: project.ChannelStable, err: "2025-R38 skipped: no Stable tab"},
+		{file: "tabpanel.html", release: "2025-R38", channel: project.ChannelRegular, err: "2025-R38 skipped: no Regular tab"},
+		{file: "labelled.html", release: "2024-R08", channel: project.ChannelRegular, want: []string{"1.28.7-gke.1026000"}},
+		{file: "labelled.html", release: "2024-R07", channel: project.ChannelStable, err: "2024-R07 skipped: no Stable tab"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.release+"/"+string(tt.channel), func(t *testing.T) {
+			p, err := ExtractChannel(sections(t, tt.file)[tt.release], tt.channel)
+			if tt.err != "" {
+				var noTab *NoChannelTabError
+				if !errors.As(err, &noTab) {
+					t.Fatalf("error = %v, want a *NoChannelTabError", err)
+				}
+				if noTab.Release != tt.release || noTab.Channel != tt.channel {
+					t.Errorf("error is for %s %s, want %s %s", noTab.Release, noTab.Channel, tt.release, tt.channel)
+				}
+				if err.Error() != tt.err {
+					t.Errorf("error = %q, want %q", err, tt.err)
+				}
+				if p != nil {
+					t.Errorf("panel = %+v, want nil", p)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := p.All(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("versions = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		role project.VersionRole
+		text []string
+		want Panel
+	}{
+		{
+			name: "leading phrases",
+			text: []string{"Default version for new clusters: 1.32.7-gke.1100000. The following versions are now available: 1.33.3-gke.1136000, 1.31.11-gke.100+cos. The following versions are no longer available: 1.30.12-gke.2000."},
+			want: Panel{
+				Default:   []string{"1.32.7-gke.1100000"},
+				Available: []string{"1.33.3-gke.1136000", "1.31.11-gke.100+cos"},
+				Removed:   []string{"1.30.12-gke.2000"},
+			},
+		},
+		{
+			name: "trailing phrases",
+			text: []string{"Version 1.31.9-gke.1044000 is now the default version. Version 1.30.12-gke.2000 is no longer available."},
+			want: Panel{
+				Default: []string{"1.31.9-gke.1044000"},
+				Removed: []string{"1.30.12-gke.2000"},
+			},
+		},
+		{
+			name: "group carried across text nodes",
+			text: []string{"The following versions are no longer available:", "1.29.1-gke.1", "1.29.2-gke.5"},
+			want: Panel{Removed: []string{"1.29.1-gke.1", "1.29.2-gke.5"}},
+		},
+		{
+			name: "no longer available is not available",
+			text: []string{"Removed: 1.28.1-gke.1", "Available: 1.30.1-gke.2"},
+			want: Panel{
+				Available: []string{"1.30.1-gke.2"},
+				Removed:   []string{"1.28.1-gke.1"},
+			},
+		},
+		{
+			name: "no phrase",
+			text: []string{"1.33.1"},
+			want: Panel{Unknown: []string{"1.33.1"}},
+		},
+		{
+			name: "incoming group",
+			role: project.RoleAvailable,
+			text: []string{"1.34.0-gke.1"},
+			want: Panel{Available: []string{"1.34.0-gke.1"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Panel
+			role := tt.role
+			for _, text := range tt.text {
+				role = classify(&got, role, text)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("classify = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package gkenotes
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+	"github.com/abdulmajid3352/codecamp/pkg/scrape"
+)
+
+// ScheduleRow is the end of support of one minor in the table of the GKE
+// release schedule page, project.GKEReleaseScheduleURL. Dates are in
+// project.DateLayout and empty when the page gives none or only an
+// estimate.
+type ScheduleRow struct {
+	Minor                kubever.Minor
+	EndOfStandardSupport string
+	EndOfExtendedSupport string
+	// Row is the table row the dates were read from.
+	Row *html.Node
+}
+
+var (
+	scheduleMinor = regexp.MustCompile(`^\d+\.\d+\b`)
+	isoDate       = regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}\b`)
+	longDate      = regexp.MustCompile(`\b(?:January|February|March|April|May|June|July|August|September|October|November|December) \d{1,2}, \d{4}\b`)
+)
+
+// ParseSchedule returns the rows of the release schedule table below doc,
+// the table whose header has an "End of standard support" column. Columns
+// are found by their header text, so added or reordered channel columns
+// are ignored. Estimated dates, which the page marks with "~" or gives as
+// a month or quarter, are left empty rather than recorded as published.
+func ParseSchedule(doc *html.Node) ([]ScheduleRow, error) {
+	for _, table := range elements(doc, atom.Table) {
+		rows := elements(table, atom.Tr)
+		if len(rows) == 0 {
+			continue
+		}
+		minorCol, standardCol, extendedCol := 0, -1, -1
+		for i, cell := range cells(rows[0]) {
+			switch text := strings.ToLower(scrape.Text(cell)); {
+			case strings.Contains(text, "end of standard support"):
+				standardCol = i
+			case strings.Contains(text, "end of extended support"):
+				extendedCol = i
+			case strings.Contains(text, "minor"):
+				minorCol = i
+			}
+		}
+		if standardCol < 0 {
+			continue
+		}
+		var out []ScheduleRow
+		for _, tr := range rows[1:] {
+			tds := cells(tr)
+			if minorCol >= len(tds) {
+				continue
+			}
+			m, err := kubever.ParseMinor(scheduleMinor.FindString(scrape.Text(tds[minorCol])))
+			if err != nil {
+				continue
+			}
+			row := ScheduleRow{Minor: m, Row: tr}
+			if row.EndOfStandardSupport, err = scheduleDate(tds, standardCol); err != nil {
+				return nil, fmt.Errorf("%s: end of standard support: %w", m, err)
+			}
+			if row.EndOfExtendedSupport, err = scheduleDate(tds, extendedCol); err != nil {
+				return nil, fmt.Errorf("%s: end of extended support: %w", m, err)
+			}
+			out = append(out, row)
+		}
+		if len(out) == 0 {
+			return nil, fmt.Errorf("the release schedule table lists no minors; the page layout may have changed")
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("no release schedule table with an end of standard support column found")
+}
+
+// scheduleDate returns the exact date in column col of tds, or "" when the
+// column is missing or gives an estimate.
+func scheduleDate(tds []*html.Node, col int) (string, error) {
+	if col < 0 || col >= len(tds) {
+		return "", nil
+	}
+	text := scrape.Text(tds[col])
+	if strings.Contains(text, "~") {
+		return "", nil
+	}
+	if s := isoDate.FindString(text); s != "" {
+		if _, err := time.Parse(project.DateLayout, s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+	if s := longDate.FindString(text); s != "" {
+		t, err := time.Parse("January 2, 2006", s)
+		if err != nil {
+			return "", err
+		}
+		return t.Format(project.DateLayout), nil
+	}
+	return "", nil
+}
+
+// elements returns the elements below n of type a, in document order.
+func elements(n *html.Node, a atom.Atom) []*html.Node {
+	var out []*html.Node
+	scrape.Walk(n, func(c *html.Node) {
+		if c.Type == html.ElementNode && c.DataAtom == a {
+			out = append(out, c)
+		}
+	})
+	return out
+}
+
+// cells returns the th and td children of tr.
+func cells(tr *html.Node) []*html.Node {
+	var out []*html.Node
+	for c := tr.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && (c.DataAtom == atom.Th || c.DataAtom == atom.Td) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,50 @@
+package gkenotes
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestParseSchedule(t *testing.T) {
+	f, err := os.Open("testdata/release_schedule.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	doc, err := html.Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := ParseSchedule(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{ minor, standard, extended string }{
+		// Estimated dates are not recorded.
+		{"1.34", "", ""},
+		{"1.33", "2026-07-31", ""},
+		{"1.32", "2026-02-28", "2027-02-28"},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("ParseSchedule = %d rows, want %d", len(rows), len(want))
+	}
+	for i, w := range want {
+		r := rows[i]
+		if r.Minor.String() != w.minor || r.EndOfStandardSupport != w.standard || r.EndOfExtendedSupport != w.extended {
+			t.Errorf("row %d = %s %q %q, want %s %q %q", i, r.Minor, r.EndOfStandardSupport, r.EndOfExtendedSupport, w.minor, w.standard, w.extended)
+		}
+	}
+}
+
+func TestParseScheduleWithoutTable(t *testing.T) {
+	doc, err := html.Parse(strings.NewReader(`<table><tr><th>Channel</th></tr><tr><td>Rapid</td></tr></table>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseSchedule(doc); err == nil {
+		t.Error("ParseSchedule without a schedule table succeeded")
+	}
+}
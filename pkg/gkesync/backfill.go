@@ -0,0 +1,255 @@
+package gkesync
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+	"github.com/abdulmajid3352/codecamp/pkg/gkenotes"
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+	"github.com/abdulmajid3352/codecamp/pkg/rewrite"
+	"github.com/abdulmajid3352/codecamp/pkg/scrape"
+)
+
+// BackfillResult summarizes a Backfill run.
+type BackfillResult struct {
+	Channel project.ReleaseChannel
+	// Resumed holds the releases a previous run already processed.
+	Resumed   []string
+	Inserted  []string
+	Updated   []string
+	Unchanged []string
+	Skipped   []string
+}
+
+// checkpoint is the progress of a Backfill run, saved after every release.
+type checkpoint struct {
+	Channel  project.ReleaseChannel `json:"channel"`
+	Variable string                 `json:"variable"`
+	From     string                 `json:"from"`
+	To       string                 `json:"to"`
+	Done     []string               `json:"done"`
+}
+
+// Backfill processes every release section between opts.From and opts.To,
+// inclusive, whether or not it is older than the highest release recorded.
+// Missing releases are inserted and releases whose versions differ from the
+// section are updated; entries that already match are left untouched.
+//
+// Details are recorded for every release inserted or updated, and for
+// releases whose recorded details lack the publication date the section
+// gives. Files are saved after every release and progress is recorded in
+// opts.Checkpoint, so a run that is interrupted resumes after the last
+// release it finished. The checkpoint is removed once the range is done.
+func Backfill(opts Options) (*BackfillResult, error) {
+	opts.setDefaults()
+	parser, err := calver.ForProject(opts.Project)
+	if err != nil {
+		return nil, err
+	}
+	var from, to calver.Version
+	if opts.From != "" {
+		if from, err = parser.Parse(opts.From); err != nil {
+			return nil, fmt.Errorf("backfill start: %w", err)
+		}
+	}
+	if opts.To != "" {
+		if to, err = parser.Parse(opts.To); err != nil {
+			return nil, fmt.Errorf("backfill end: %w", err)
+		}
+	}
+	if !from.IsZero() && !to.IsZero() && to.Less(from) {
+		return nil, fmt.Errorf("backfill range %s..%s is reversed", opts.From, opts.To)
+	}
+
+	cp := &checkpoint{Channel: opts.Channel, Variable: opts.Variable, From: opts.From, To: opts.To}
+	done := map[calver.Version]bool{}
+	if opts.Checkpoint != "" {
+		prev, err := loadCheckpoint(opts.Checkpoint)
+		if err != nil {
+			return nil, err
+		}
+		if prev != nil {
+			if prev.Channel != cp.Channel || prev.Variable != cp.Variable || prev.From != cp.From || prev.To != cp.To {
+				return nil, fmt.Errorf("checkpoint %s is for %s %s..%s; remove it to start another backfill",
+					opts.Checkpoint, prev.Variable, prev.From, prev.To)
+			}
+			cp.Done = prev.Done
+			for _, r := range prev.Done {
+				if v, err := parser.Parse(r); err == nil {
+					done[v] = true
+				}
+			}
+		}
+	}
+
+	sections, fetchedAt, err := loadSections(opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(sections) == 0 {
+		return nil, fmt.Errorf("no release sections found; the release notes layout may have changed")
+	}
+
+	res := &BackfillResult{Channel: opts.Channel}
+	for _, s := range sections {
+		v, err := parser.Parse(s.Key)
+		if err != nil {
+			return res, err
+		}
+		if (!from.IsZero() && v.Less(from)) || (!to.IsZero() && to.Less(v)) {
+			continue
+		}
+		if done[v] {
+			res.Resumed = append(res.Resumed, s.Key)
+			continue
+		}
+		panel, err := gkenotes.ExtractChannel(s, opts.Channel)
+		var noTab *gkenotes.NoChannelTabError
+		switch {
+		case errors.As(err, &noTab):
+			fmt.Fprintln(opts.Log, noTab)
+			res.Skipped = append(res.Skipped, s.Key)
+		case err != nil:
+			return res, fmt.Errorf("extracting %s: %w", s.Key, err)
+		default:
+			change, err := backfillRelease(opts, parser, s.Key, panel)
+			if err != nil {
+				return res, fmt.Errorf("backfilling %s: %w", s.Key, err)
+			}
+			switch change {
+			case rewrite.Inserted:
+				res.Inserted = append(res.Inserted, s.Key)
+			case rewrite.Updated:
+				res.Updated = append(res.Updated, s.Key)
+			default:
+				res.Unchanged = append(res.Unchanged, s.Key)
+			}
+			recorded, err := loadDetails(opts.DetailsFile)
+			if err != nil {
+				return res, err
+			}
+			prev := detailOf(parser, recorded, s.Key)
+			if (change != rewrite.Unchanged || undated(s, prev)) && !opts.DryRun {
+				source, err := provenance(s, fetchedAt)
+				if err != nil {
+					return res, fmt.Errorf("backfilling %s: %w", s.Key, err)
+				}
+				d := panelDetail(prev, s, source, panel)
+				if err := saveDetails(parser, opts.DetailsFile, []project.ReleaseDetail{d}); err != nil {
+					return res, err
+				}
+			}
+		}
+		if opts.Checkpoint != "" && !opts.DryRun {
+			cp.Done = append(cp.Done, s.Key)
+			if err := saveCheckpoint(opts.Checkpoint, cp); err != nil {
+				return res, err
+			}
+		}
+	}
+
+	if opts.Checkpoint != "" && !opts.DryRun {
+		if err := os.Remove(opts.Checkpoint); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return res, err
+		}
+	}
+	res.print(opts.Log)
+	return res, nil
+}
+
+// undated reports whether s gives a publication date that d, the details
+// recorded for its release or nil, does not.
+func undated(s *scrape.Section, d *project.ReleaseDetail) bool {
+	if gkenotes.ExtractDates(s).Published.IsZero() {
+		return false
+	}
+	return d == nil || d.Published == ""
+}
+
+// backfillRelease upserts the versions of panel as release into
+// opts.GoFile and saves it, unless nothing changed or opts.DryRun is set.
+func backfillRelease(opts Options, parser *calver.Parser, release string, panel *gkenotes.Panel) (rewrite.Change, error) {
+	file, err := rewrite.Load(opts.GoFile)
+	if err != nil {
+		return rewrite.Unchanged, err
+	}
+	change, err := file.Upsert(parser, opts.Variable, rewrite.Release{
+		Project:                projectExpr,
+		Version:                release,
+		RelatedProjectReleases: kubever.SortedRefs(panel.Kube()),
+	})
+	if err != nil || change == rewrite.Unchanged || opts.DryRun {
+		return change, err
+	}
+	return change, file.Save(opts.GoFile)
+}
+
+func (res *BackfillResult) print(w io.Writer) {
+	fmt.Fprintf(w, "Channel: %s\n", res.Channel)
+	if len(res.Resumed) > 0 {
+		fmt.Fprintf(w, "Resumed after: %d releases\n", len(res.Resumed))
+	}
+	for _, group := range []struct {
+		label    string
+		releases []string
+	}{
+		{"Inserted", res.Inserted},
+		{"Updated", res.Updated},
+		{"Unchanged", res.Unchanged},
+		{"Skipped", res.Skipped},
+	} {
+		fmt.Fprintf(w, "%s: %d\n", group.label, len(group.releases))
+		for _, r := range group.releases {
+			if group.label != "Unchanged" {
+				fmt.Fprintf(w, "  %s\n", r)
+			}
+		}
+	}
+}
+
+// loadCheckpoint reads the checkpoint at path, or returns nil when there is
+// none.
+func loadCheckpoint(path string) (*checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cp checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("reading checkpoint %s: %w", path, err)
+	}
+	return &cp, nil
+}
+
+// saveCheckpoint writes cp to path through a temporary file, so an
+// interrupted write leaves the previous checkpoint intact.
+func saveCheckpoint(path string, cp *checkpoint) error {
+	data, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
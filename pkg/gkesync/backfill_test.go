@@ -0,0 +1,87 @@
+package gkesync
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+)
+
+func TestBackfillResumes(t *testing.T) {
+	dir := t.TempDir()
+	opts := Options{
+		GoFile:      writeFile(t, dir, "gke.go", releasesSrc),
+		DetailsFile: writeFile(t, dir, "gke_release_details.go", detailsSrc),
+		HTMLFile:    "testdata/release_notes.html",
+		From:        "2025-R37",
+		To:          "2025-R39",
+		Checkpoint:  filepath.Join(dir, "backfill.json"),
+		Log:         io.Discard,
+	}
+
+	// Leave the files and checkpoint as a run interrupted after 2025-R39
+	// would: the release is processed and recorded as done.
+	first := opts
+	first.From, first.Checkpoint = "2025-R39", ""
+	if _, err := Backfill(first); err != nil {
+		t.Fatal(err)
+	}
+	cp := &checkpoint{Channel: project.ChannelStable, Variable: "GKEProjectReleases", From: opts.From, To: opts.To, Done: []string{"2025-R39"}}
+	if err := saveCheckpoint(opts.Checkpoint, cp); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := Backfill(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(res.Resumed, []string{"2025-R39"}) || !reflect.DeepEqual(res.Inserted, []string{"2025-R38"}) ||
+		len(res.Updated)+len(res.Unchanged) != 1 {
+		t.Errorf("resumed Backfill = %+v, want 2025-R39 resumed, 2025-R38 inserted and 2025-R37 processed", res)
+	}
+	src, err := os.ReadFile(opts.GoFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range []string{"2025-R39", "2025-R38", "2025-R37"} {
+		if n := strings.Count(string(src), `"`+r+`"`); n != 1 {
+			t.Errorf("%s is recorded %d times, want once:\n%s", r, n, src)
+		}
+	}
+	details, err := loadDetails(opts.DetailsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(details) != 3 {
+		t.Errorf("GKEReleaseDetails = %+v, want 2025-R39, R38 and R37 once each", details)
+	}
+	if _, err := os.Stat(opts.Checkpoint); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("checkpoint left after the range is done: %v", err)
+	}
+}
+
+func TestBackfillRejectsOtherCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+	opts := Options{
+		GoFile:      writeFile(t, dir, "gke.go", releasesSrc),
+		DetailsFile: writeFile(t, dir, "gke_release_details.go", detailsSrc),
+		HTMLFile:    "testdata/release_notes.html",
+		From:        "2025-R37",
+		To:          "2025-R39",
+		Checkpoint:  filepath.Join(dir, "backfill.json"),
+		Log:         io.Discard,
+	}
+	cp := &checkpoint{Channel: project.ChannelStable, Variable: "GKEProjectReleases", From: "2025-R01", To: opts.To, Done: []string{"2025-R39"}}
+	if err := saveCheckpoint(opts.Checkpoint, cp); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Backfill(opts); err == nil || !strings.Contains(err.Error(), "remove it to start another backfill") {
+		t.Errorf("Backfill with the checkpoint of another range = %v, want an error", err)
+	}
+}
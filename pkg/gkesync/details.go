@@ -0,0 +1,193 @@
+package gkesync
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+	"github.com/abdulmajid3352/codecamp/pkg/gkenotes"
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+	"github.com/abdulmajid3352/codecamp/pkg/rewrite"
+	"github.com/abdulmajid3352/codecamp/pkg/scrape"
+)
+
+// detailsVariable is the slice holding full build versions.
+const detailsVariable = "GKEReleaseDetails"
+
+// loadDetails reads the GKEReleaseDetails slice recorded in path, or
+// returns none when path is empty.
+func loadDetails(path string) ([]project.ReleaseDetail, error) {
+	if path == "" {
+		return nil, nil
+	}
+	file, err := rewrite.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	var details []project.ReleaseDetail
+	if err := file.Decode(detailsVariable, &details, literalIdents()); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return details, nil
+}
+
+// detailOf returns the detail of release in details, padded or not, or nil.
+func detailOf(parser *calver.Parser, details []project.ReleaseDetail, release string) *project.ReleaseDetail {
+	want, err := parser.Parse(release)
+	if err != nil {
+		return nil
+	}
+	for i := range details {
+		if v, err := parser.Parse(details[i].Version); err == nil && v == want {
+			return &details[i]
+		}
+	}
+	return nil
+}
+
+// panelDetail returns the details of the release of s read from source,
+// with the builds of panel in place of the builds prev, the details
+// recorded so far or nil, lists for its channel. Dates the section does not
+// give are kept from prev.
+func panelDetail(prev *project.ReleaseDetail, s *scrape.Section, source *project.Provenance, panel *gkenotes.Panel) project.ReleaseDetail {
+	d := project.ReleaseDetail{Version: s.Key, Source: source}
+	if prev != nil {
+		d.Published, d.RolloutStart, d.RolloutComplete = prev.Published, prev.RolloutStart, prev.RolloutComplete
+	}
+	dates := gkenotes.ExtractDates(s)
+	setDate(&d.Published, dates.Published)
+	setDate(&d.RolloutStart, dates.RolloutStart)
+	setDate(&d.RolloutComplete, dates.RolloutComplete)
+	if prev != nil {
+		for _, b := range prev.Builds {
+			if b.Channel != panel.Channel {
+				d.Builds = append(d.Builds, b)
+			}
+		}
+	}
+	d.Builds = append(d.Builds, panelBuilds(panel)...)
+	return d
+}
+
+// setDate sets *field to t in project.DateLayout, unless t is unknown.
+func setDate(field *string, t time.Time) {
+	if !t.IsZero() {
+		*field = t.Format(project.DateLayout)
+	}
+}
+
+// panelBuilds returns the distinct full GKE versions of panel with their
+// roles, in build order.
+func panelBuilds(panel *gkenotes.Panel) []project.ReleaseBuild {
+	type roleBuild struct {
+		build kubever.Build
+		role  project.VersionRole
+	}
+	seen := map[project.ReleaseBuild]bool{}
+	var builds []roleBuild
+	for role, versions := range panel.Roles() {
+		for _, s := range versions {
+			b, err := kubever.ParseBuild(s)
+			if err != nil {
+				continue
+			}
+			key := project.ReleaseBuild{Role: role, Version: b.String()}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			builds = append(builds, roleBuild{build: b, role: role})
+		}
+	}
+	sort.Slice(builds, func(i, j int) bool {
+		if c := builds[i].build.Compare(builds[j].build); c != 0 {
+			return c < 0
+		}
+		return roleOrder[builds[i].role] < roleOrder[builds[j].role]
+	})
+	out := make([]project.ReleaseBuild, len(builds))
+	for i, b := range builds {
+		out[i] = project.ReleaseBuild{Channel: panel.Channel, Role: b.role, Version: b.build.String()}
+	}
+	return out
+}
+
+var roleOrder = map[project.VersionRole]int{
+	project.RoleDefault:    0,
+	project.RoleAvailable:  1,
+	project.RoleRemoved:    2,
+	project.RoleUnlabelled: 3,
+}
+
+// roleConstants names the VersionRole constants in generated source.
+var roleConstants = map[project.VersionRole]string{
+	project.RoleDefault:   "RoleDefault",
+	project.RoleAvailable: "RoleAvailable",
+	project.RoleRemoved:   "RoleRemoved",
+}
+
+// literalIdents resolves the constants generated literals use, e.g.
+// ChannelStable, RoleDefault or GapAbsent.
+func literalIdents() map[string]any {
+	idents := map[string]any{}
+	for _, c := range project.ReleaseChannels {
+		idents["Channel"+string(c)] = c
+	}
+	for role, name := range roleConstants {
+		idents[name] = role
+	}
+	for outcome, name := range gapOutcomeConstants {
+		idents[name] = outcome
+	}
+	return idents
+}
+
+// detailLiteral renders d as an element of GKEReleaseDetails.
+func detailLiteral(d project.ReleaseDetail) string {
+	var b strings.Builder
+	b.WriteString("{\n")
+	fmt.Fprintf(&b, "Version: %s,\n", strconv.Quote(d.Version))
+	writeSource(&b, d.Source)
+	for _, date := range []struct{ field, value string }{
+		{"Published", d.Published},
+		{"RolloutStart", d.RolloutStart},
+		{"RolloutComplete", d.RolloutComplete},
+	} {
+		if date.value != "" {
+			fmt.Fprintf(&b, "%s: %s,\n", date.field, strconv.Quote(date.value))
+		}
+	}
+	b.WriteString("Builds: []ReleaseBuild{\n")
+	for _, build := range d.Builds {
+		fmt.Fprintf(&b, "{Channel: Channel%s, ", build.Channel)
+		if name, ok := roleConstants[build.Role]; ok {
+			fmt.Fprintf(&b, "Role: %s, ", name)
+		}
+		fmt.Fprintf(&b, "Version: %s},\n", strconv.Quote(build.Version))
+	}
+	b.WriteString("},\n}")
+	return b.String()
+}
+
+// writeSource renders the Source field of a generated element, if any.
+func writeSource(b *strings.Builder, src *project.Provenance) {
+	if src == nil {
+		return
+	}
+	b.WriteString("Source: &Provenance{\n")
+	fmt.Fprintf(b, "URL: %s,\n", strconv.Quote(src.URL))
+	if src.Anchor != "" {
+		fmt.Fprintf(b, "Anchor: %s,\n", strconv.Quote(src.Anchor))
+	}
+	if src.FetchedAt != "" {
+		fmt.Fprintf(b, "FetchedAt: %s,\n", strconv.Quote(src.FetchedAt))
+	}
+	if src.ContentHash != "" {
+		fmt.Fprintf(b, "ContentHash: %s,\n", strconv.Quote(src.ContentHash))
+	}
+	b.WriteString("},\n")
+}
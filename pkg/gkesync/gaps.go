@@ -0,0 +1,223 @@
+package gkesync
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+	"github.com/abdulmajid3352/codecamp/pkg/catalog"
+	"github.com/abdulmajid3352/codecamp/pkg/gkenotes"
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+	"github.com/abdulmajid3352/codecamp/pkg/rewrite"
+)
+
+// gapsVariable is the slice holding the outcomes of CheckGaps.
+const gapsVariable = "GKEReleaseGaps"
+
+// Gap is a missing release checked by CheckGaps.
+type Gap struct {
+	Release string
+	// Outcome is empty when the release notes read do not cover the
+	// release, so nothing could be concluded.
+	Outcome project.GapOutcome
+	// Refs holds the versions the channel's panel lists, for releases that
+	// need backfill.
+	Refs   []string
+	Source *project.Provenance
+}
+
+// GapResult summarizes a CheckGaps run.
+type GapResult struct {
+	Channel project.ReleaseChannel
+	Gaps    []Gap
+}
+
+// CheckGaps lists the R numbers missing from the releases in opts.GoFile,
+// looks each one up in the release notes and records whether it is absent,
+// has no tab for the channel or needs backfill in opts.GapsFile. It prints
+// a report to opts.Log.
+func CheckGaps(opts Options) (*GapResult, error) {
+	opts.setDefaults()
+	parser, err := calver.ForProject(opts.Project)
+	if err != nil {
+		return nil, err
+	}
+	file, err := rewrite.Load(opts.GoFile)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := file.Releases(opts.Variable)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", opts.GoFile, err)
+	}
+	releases := make([]model.ProjectRelease, len(existing))
+	for i, e := range existing {
+		releases[i] = model.ProjectRelease{Version: e.Version}
+	}
+	years, err := catalog.MissingReleases(opts.Project, releases)
+	if err != nil {
+		return nil, err
+	}
+
+	sections, fetchedAt, err := loadSections(opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(sections) == 0 {
+		return nil, fmt.Errorf("no release sections found; the release notes layout may have changed")
+	}
+	byVersion := map[calver.Version]int{}
+	var oldest, newest calver.Version
+	for i, s := range sections {
+		v, err := parser.Parse(s.Key)
+		if err != nil {
+			return nil, err
+		}
+		byVersion[v] = i
+		if oldest.IsZero() || v.Less(oldest) {
+			oldest = v
+		}
+		if newest.Less(v) {
+			newest = v
+		}
+	}
+
+	res := &GapResult{Channel: opts.Channel}
+	page := &project.Provenance{
+		URL:       opts.Config.Series.Sources[0].LinkTemplate.URLTemplate,
+		FetchedAt: fetchedAt.Format(time.RFC3339),
+	}
+	for _, y := range years {
+		for _, release := range y.Missing {
+			v, _ := parser.Parse(release)
+			g := Gap{Release: release}
+			i, ok := byVersion[v]
+			switch {
+			case !ok && (v.Less(oldest) || newest.Less(v)):
+			case !ok:
+				g.Outcome, g.Source = project.GapAbsent, page
+			default:
+				s := sections[i]
+				if g.Source, err = provenance(s, fetchedAt); err != nil {
+					return res, fmt.Errorf("checking %s: %w", release, err)
+				}
+				panel, err := gkenotes.ExtractChannel(s, opts.Channel)
+				var noTab *gkenotes.NoChannelTabError
+				switch {
+				case errors.As(err, &noTab):
+					g.Outcome = project.GapNoChannel
+				case err != nil:
+					return res, fmt.Errorf("checking %s: %w", release, err)
+				default:
+					g.Outcome = project.GapBackfill
+					g.Refs = kubever.SortedRefs(panel.Kube())
+				}
+			}
+			res.Gaps = append(res.Gaps, g)
+		}
+	}
+
+	if !opts.DryRun {
+		if err := saveGaps(parser, opts.GapsFile, opts.Channel, res.Gaps); err != nil {
+			return res, err
+		}
+	}
+	res.print(opts.Log)
+	return res, nil
+}
+
+func (res *GapResult) print(w io.Writer) {
+	fmt.Fprintf(w, "Channel: %s\n", res.Channel)
+	fmt.Fprintf(w, "Missing releases: %d\n", len(res.Gaps))
+	year := ""
+	for _, g := range res.Gaps {
+		if y, _, _ := strings.Cut(g.Release, "-"); y != year {
+			year = y
+			fmt.Fprintf(w, "%s:\n", year)
+		}
+		switch g.Outcome {
+		case "":
+			fmt.Fprintf(w, "  %s: not covered by the release notes read\n", g.Release)
+		case project.GapBackfill:
+			fmt.Fprintf(w, "  %s: %s, %d versions\n", g.Release, g.Outcome, len(g.Refs))
+		default:
+			fmt.Fprintf(w, "  %s: %s\n", g.Release, g.Outcome)
+		}
+	}
+}
+
+// saveGaps records the outcomes of gaps for channel in the GKEReleaseGaps
+// slice of path, keeping the outcomes path records for other channels.
+func saveGaps(parser *calver.Parser, path string, channel project.ReleaseChannel, gaps []Gap) error {
+	if path == "" {
+		return nil
+	}
+	file, err := rewrite.Load(path)
+	if err != nil {
+		return err
+	}
+	var recorded []project.ReleaseGap
+	if err := file.Decode(gapsVariable, &recorded, literalIdents()); err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+	for _, g := range gaps {
+		if g.Outcome == "" {
+			continue
+		}
+		rg := project.ReleaseGap{Version: g.Release, Source: g.Source, Outcomes: map[project.ReleaseChannel]project.GapOutcome{}}
+		if prev := gapOf(parser, recorded, g.Release); prev != nil {
+			for c, o := range prev.Outcomes {
+				rg.Outcomes[c] = o
+			}
+		}
+		rg.Outcomes[channel] = g.Outcome
+		if _, err := file.Put(parser, gapsVariable, g.Release, gapLiteral(rg)); err != nil {
+			return fmt.Errorf("recording %s gap: %w", g.Release, err)
+		}
+	}
+	return file.Save(path)
+}
+
+// gapOf returns the gap of release in gaps, padded or not, or nil.
+func gapOf(parser *calver.Parser, gaps []project.ReleaseGap, release string) *project.ReleaseGap {
+	want, err := parser.Parse(release)
+	if err != nil {
+		return nil
+	}
+	for i := range gaps {
+		if v, err := parser.Parse(gaps[i].Version); err == nil && v == want {
+			return &gaps[i]
+		}
+	}
+	return nil
+}
+
+// gapOutcomeConstants names the GapOutcome constants in generated source.
+var gapOutcomeConstants = map[project.GapOutcome]string{
+	project.GapAbsent:    "GapAbsent",
+	project.GapNoChannel: "GapNoChannel",
+	project.GapBackfill:  "GapBackfill",
+}
+
+// gapLiteral renders g as an element of GKEReleaseGaps.
+func gapLiteral(g project.ReleaseGap) string {
+	var b strings.Builder
+	b.WriteString("{\n")
+	fmt.Fprintf(&b, "Version: %s,\n", strconv.Quote(g.Version))
+	writeSource(&b, g.Source)
+	b.WriteString("Outcomes: map[ReleaseChannel]GapOutcome{\n")
+	for _, c := range project.ReleaseChannels {
+		if o, ok := g.Outcomes[c]; ok {
+			fmt.Fprintf(&b, "Channel%s: %s,\n", c, gapOutcomeConstants[o])
+		}
+	}
+	b.WriteString("},\n}")
+	return b.String()
+}
@@ -0,0 +1,53 @@
+package gkesync
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+	"github.com/abdulmajid3352/codecamp/pkg/rewrite"
+)
+
+func TestSaveGapsMergesRecordedOutcomes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "gke_gaps.go")
+	src := `package project
+
+var GKEReleaseGaps = []ReleaseGap{
+	{
+		Version: "2025-R21",
+		Outcomes: map[ReleaseChannel]GapOutcome{
+			ChannelRapid: GapAbsent,
+		},
+	},
+}
+`
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	parser, err := calver.ForProject(&project.GKE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gaps := []Gap{{Release: "2025-R21", Outcome: project.GapNoChannel}}
+	if err := saveGaps(parser, path, project.ChannelStable, gaps); err != nil {
+		t.Fatal(err)
+	}
+	file, err := rewrite.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []project.ReleaseGap
+	if err := file.Decode(gapsVariable, &got, literalIdents()); err != nil {
+		t.Fatal(err)
+	}
+	want := map[project.ReleaseChannel]project.GapOutcome{
+		project.ChannelRapid:  project.GapAbsent,
+		project.ChannelStable: project.GapNoChannel,
+	}
+	if len(got) != 1 || !reflect.DeepEqual(got[0].Outcomes, want) {
+		t.Errorf("recorded gaps = %+v, want 2025-R21 with outcomes %v", got, want)
+	}
+}
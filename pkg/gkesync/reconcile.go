@@ -0,0 +1,198 @@
+package gkesync
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+	"github.com/abdulmajid3352/codecamp/pkg/gkenotes"
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+	"github.com/abdulmajid3352/codecamp/pkg/rewrite"
+)
+
+// Drift is how a recorded release differs from its section in the release
+// notes.
+type Drift struct {
+	Release string
+	// Missing holds the versions the section lists that are not recorded.
+	Missing []string
+	// Extra holds the recorded versions the section does not list.
+	Extra []string
+	// Provenance describes problems with the recorded source of the
+	// release, or with finding its section at all.
+	Provenance []string
+}
+
+// ReconcileResult summarizes a Reconcile run.
+type ReconcileResult struct {
+	Channel project.ReleaseChannel
+	// Checked is the number of recorded releases compared.
+	Checked int
+	// Drifts holds the releases that differ, in the order they are
+	// recorded.
+	Drifts []Drift
+	// Unrecorded holds the releases the release notes list that are not
+	// recorded, newest first; Run or Backfill records them.
+	Unrecorded []string
+}
+
+// Reconcile re-extracts every release recorded in opts.GoFile from the
+// release notes and reports, per release, the versions missing from or
+// extra to the record, and problems with its recorded provenance. Nothing
+// is written; mismatches are fixed with Backfill. Releases older than the
+// release notes read are not compared, and releases the notes list that are
+// not recorded are reported as Unrecorded.
+func Reconcile(opts Options) (*ReconcileResult, error) {
+	opts.setDefaults()
+	parser, err := calver.ForProject(opts.Project)
+	if err != nil {
+		return nil, err
+	}
+	file, err := rewrite.Load(opts.GoFile)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := file.Releases(opts.Variable)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", opts.GoFile, err)
+	}
+	recorded, err := loadDetails(opts.DetailsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	sections, fetchedAt, err := loadSections(opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(sections) == 0 {
+		return nil, fmt.Errorf("no release sections found; the release notes layout may have changed")
+	}
+	byVersion := map[calver.Version]int{}
+	var oldest calver.Version
+	for i, s := range sections {
+		v, err := parser.Parse(s.Key)
+		if err != nil {
+			return nil, err
+		}
+		byVersion[v] = i
+		if oldest.IsZero() || v.Less(oldest) {
+			oldest = v
+		}
+	}
+
+	res := &ReconcileResult{Channel: opts.Channel}
+	seen := map[calver.Version]bool{}
+	for _, e := range existing {
+		v, err := parser.Parse(e.Version)
+		if err != nil {
+			return res, err
+		}
+		seen[v] = true
+		if v.Less(oldest) {
+			continue
+		}
+		res.Checked++
+		d := Drift{Release: e.Version}
+		i, ok := byVersion[v]
+		if !ok {
+			d.Provenance = append(d.Provenance, "no section in the release notes")
+			res.Drifts = append(res.Drifts, d)
+			continue
+		}
+		s := sections[i]
+		panel, err := gkenotes.ExtractChannel(s, opts.Channel)
+		var noTab *gkenotes.NoChannelTabError
+		switch {
+		case errors.As(err, &noTab):
+			d.Provenance = append(d.Provenance, fmt.Sprintf("section has no %s tab", opts.Channel))
+			d.Extra = e.RelatedProjectReleases
+		case err != nil:
+			return res, fmt.Errorf("extracting %s: %w", e.Version, err)
+		default:
+			d.Missing, d.Extra = compareRefs(kubever.SortedRefs(panel.Kube()), e.RelatedProjectReleases)
+		}
+		source, err := provenance(s, fetchedAt)
+		if err != nil {
+			return res, fmt.Errorf("extracting %s: %w", e.Version, err)
+		}
+		d.Provenance = append(d.Provenance, checkProvenance(detailOf(parser, recorded, e.Version), source)...)
+		if len(d.Missing) > 0 || len(d.Extra) > 0 || len(d.Provenance) > 0 {
+			res.Drifts = append(res.Drifts, d)
+		}
+	}
+	for _, s := range sections {
+		if v, _ := parser.Parse(s.Key); !seen[v] {
+			res.Unrecorded = append(res.Unrecorded, s.Key)
+		}
+	}
+	res.print(opts.Log)
+	return res, nil
+}
+
+// compareRefs returns the refs of want absent from got and the refs of got
+// absent from want, each in the order given.
+func compareRefs(want, got []string) (missing, extra []string) {
+	inGot := map[string]bool{}
+	for _, r := range got {
+		inGot[r] = true
+	}
+	inWant := map[string]bool{}
+	for _, r := range want {
+		inWant[r] = true
+		if !inGot[r] {
+			missing = append(missing, r)
+		}
+	}
+	for _, r := range got {
+		if !inWant[r] {
+			extra = append(extra, r)
+		}
+	}
+	return missing, extra
+}
+
+// checkProvenance compares the source recorded in d, the details of a
+// release or nil, with source, the section it was just read from. Sources
+// curated before content hashes were recorded are only compared by URL and
+// anchor.
+func checkProvenance(d *project.ReleaseDetail, source *project.Provenance) []string {
+	if d == nil || d.Source == nil {
+		return []string{"no source recorded"}
+	}
+	var problems []string
+	if d.Source.URL != source.URL {
+		problems = append(problems, fmt.Sprintf("recorded URL %s, read from %s", d.Source.URL, source.URL))
+	}
+	if d.Source.Anchor != source.Anchor {
+		problems = append(problems, fmt.Sprintf("recorded anchor %q, section is %q", d.Source.Anchor, source.Anchor))
+	}
+	if d.Source.ContentHash != "" && d.Source.ContentHash != source.ContentHash {
+		problems = append(problems, "section changed since it was curated")
+	}
+	return problems
+}
+
+func (res *ReconcileResult) print(w io.Writer) {
+	fmt.Fprintf(w, "Channel: %s\n", res.Channel)
+	fmt.Fprintf(w, "Checked: %d\n", res.Checked)
+	fmt.Fprintf(w, "Drifted: %d\n", len(res.Drifts))
+	fmt.Fprintf(w, "Unrecorded: %d\n", len(res.Unrecorded))
+	for _, r := range res.Unrecorded {
+		fmt.Fprintf(w, "  %s\n", r)
+	}
+	for _, d := range res.Drifts {
+		fmt.Fprintf(w, "%s:\n", d.Release)
+		for _, r := range d.Missing {
+			fmt.Fprintf(w, "  missing %s\n", r)
+		}
+		for _, r := range d.Extra {
+			fmt.Fprintf(w, "  extra %s\n", r)
+		}
+		for _, p := range d.Provenance {
+			fmt.Fprintf(w, "  provenance: %s\n", p)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package gkesync
+
+import (
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestReconcile(t *testing.T) {
+	res, err := Reconcile(Options{
+		GoFile:      "testdata/reconcile_releases.go.txt",
+		DetailsFile: "testdata/reconcile_details.go.txt",
+		HTMLFile:    "testdata/release_notes.html",
+		Log:         io.Discard,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2025-R30 is older than the notes read and is not compared.
+	if res.Checked != 3 {
+		t.Errorf("Checked = %d, want 3", res.Checked)
+	}
+	want := []Drift{
+		// The catalog is ahead of the notes: a release they do not list...
+		{Release: "2025-R40", Provenance: []string{"no section in the release notes"}},
+		// ...and a version they do not list; the notes are ahead of the
+		// catalog with a version it does not record.
+		{Release: "2025-R39", Missing: []string{"kube@1.32.8"}, Extra: []string{"kube@1.29.15"}},
+	}
+	if !reflect.DeepEqual(res.Drifts, want) {
+		t.Errorf("Drifts = %+v\nwant %+v", res.Drifts, want)
+	}
+	// The notes are ahead of the catalog with a release it does not record.
+	if !reflect.DeepEqual(res.Unrecorded, []string{"2025-R38"}) {
+		t.Errorf("Unrecorded = %v, want [2025-R38]", res.Unrecorded)
+	}
+}
+
+func TestReconcileInSync(t *testing.T) {
+	dir := t.TempDir()
+	opts := Options{
+		GoFile:      writeFile(t, dir, "gke.go", releasesSrc),
+		DetailsFile: writeFile(t, dir, "gke_release_details.go", detailsSrc),
+		HTMLFile:    "testdata/release_notes.html",
+		Log:         io.Discard,
+	}
+	if _, err := Run(opts); err != nil {
+		t.Fatal(err)
+	}
+	res, err := Reconcile(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// detailsSrc records no source for 2025-R37.
+	want := []Drift{{Release: "2025-R37", Provenance: []string{"no source recorded"}}}
+	if res.Checked != 3 || len(res.Unrecorded) != 0 || !reflect.DeepEqual(res.Drifts, want) {
+		t.Errorf("Reconcile after Run = %+v, want 3 releases checked and only %+v", res, want)
+	}
+}
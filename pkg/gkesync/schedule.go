@@ -0,0 +1,180 @@
+package gkesync
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+
+	"github.com/abdulmajid3352/codecamp/pkg/gkenotes"
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+	"github.com/abdulmajid3352/codecamp/pkg/rewrite"
+	"github.com/abdulmajid3352/codecamp/pkg/scrape"
+)
+
+// supportVariable is the slice holding the end of support dates.
+const supportVariable = "GKEMinorSupport"
+
+// ScheduleResult summarizes a SyncSchedule run.
+type ScheduleResult struct {
+	// Support holds every minor recorded after the run, newest first.
+	Support []project.MinorSupport
+	// Changed holds the minors whose dates were added or changed.
+	Changed []string
+}
+
+// SyncSchedule reads the end of support dates of each Kubernetes minor from
+// the GKE release schedule page, or opts.HTMLFile when set, into the
+// GKEMinorSupport slice of opts.SupportFile. Minors the page no longer
+// lists keep their recorded dates, as do dates the page only estimates. It
+// prints a report to opts.Log.
+func SyncSchedule(opts Options) (*ScheduleResult, error) {
+	opts.setDefaults()
+	fetch, fetchedAt := scrape.HTTP(nil), time.Now()
+	if opts.HTMLFile != "" {
+		info, err := os.Stat(opts.HTMLFile)
+		if err != nil {
+			return nil, err
+		}
+		fetch, fetchedAt = scrape.Snapshot(opts.HTMLFile), info.ModTime()
+	}
+	fetchedAt = fetchedAt.UTC().Truncate(time.Second)
+	r, err := fetch(project.GKEReleaseScheduleURL)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := html.Parse(r)
+	r.Close()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := gkenotes.ParseSchedule(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := rewrite.Load(opts.SupportFile)
+	if err != nil {
+		return nil, err
+	}
+	var recorded []project.MinorSupport
+	if err := file.Decode(supportVariable, &recorded, literalIdents()); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", opts.SupportFile, err)
+	}
+	byMinor := map[kubever.Minor]project.MinorSupport{}
+	for _, s := range recorded {
+		m, err := kubever.ParseMinor(s.Minor)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", opts.SupportFile, err)
+		}
+		byMinor[m] = s
+	}
+	res := &ScheduleResult{}
+	for _, row := range rows {
+		prev := byMinor[row.Minor]
+		s := prev
+		s.Minor = row.Minor.String()
+		setIfKnown(&s.EndOfStandardSupport, row.EndOfStandardSupport)
+		setIfKnown(&s.EndOfExtendedSupport, row.EndOfExtendedSupport)
+		if s.EndOfStandardSupport == prev.EndOfStandardSupport && s.EndOfExtendedSupport == prev.EndOfExtendedSupport && prev.Source != nil {
+			continue
+		}
+		if s.Source, err = rowProvenance(row, fetchedAt); err != nil {
+			return nil, err
+		}
+		byMinor[row.Minor] = s
+		res.Changed = append(res.Changed, s.Minor)
+	}
+	minors := make([]kubever.Minor, 0, len(byMinor))
+	for m := range byMinor {
+		minors = append(minors, m)
+	}
+	sort.Slice(minors, func(i, j int) bool { return minors[j].Less(minors[i]) })
+	for _, m := range minors {
+		res.Support = append(res.Support, byMinor[m])
+	}
+	if err := project.ValidateMinorSupport(opts.Project, res.Support); err != nil {
+		return res, err
+	}
+
+	if len(res.Changed) > 0 && !opts.DryRun {
+		if _, err := file.SetField(supportVariable, nil, supportLiteral(res.Support)); err != nil {
+			return res, err
+		}
+		if err := file.Save(opts.SupportFile); err != nil {
+			return res, err
+		}
+	}
+	res.print(opts.Log)
+	return res, nil
+}
+
+// setIfKnown sets *field to date unless the page gave none.
+func setIfKnown(field *string, date string) {
+	if date != "" {
+		*field = date
+	}
+}
+
+// rowProvenance records where row was read from.
+func rowProvenance(row gkenotes.ScheduleRow, fetchedAt time.Time) (*project.Provenance, error) {
+	var b bytes.Buffer
+	if err := html.Render(&b, row.Row); err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(b.Bytes())
+	return &project.Provenance{
+		URL:         project.GKEReleaseScheduleURL,
+		FetchedAt:   fetchedAt.Format(time.RFC3339),
+		ContentHash: "sha256:" + hex.EncodeToString(sum[:]),
+	}, nil
+}
+
+func (res *ScheduleResult) print(w io.Writer) {
+	fmt.Fprintf(w, "Minors recorded: %d\n", len(res.Support))
+	fmt.Fprintf(w, "Changed: %d\n", len(res.Changed))
+	for _, minor := range res.Changed {
+		for _, s := range res.Support {
+			if s.Minor == minor {
+				fmt.Fprintf(w, "  %s: standard support ends %s, extended support ends %s\n",
+					s.Minor, orNotPublished(s.EndOfStandardSupport), orNotPublished(s.EndOfExtendedSupport))
+			}
+		}
+	}
+}
+
+func orNotPublished(date string) string {
+	if date == "" {
+		return "(not published)"
+	}
+	return date
+}
+
+// supportLiteral renders support as the value of GKEMinorSupport.
+func supportLiteral(support []project.MinorSupport) string {
+	var b strings.Builder
+	b.WriteString("[]MinorSupport{\n")
+	for _, s := range support {
+		b.WriteString("{\n")
+		fmt.Fprintf(&b, "Minor: %s,\n", strconv.Quote(s.Minor))
+		if s.EndOfStandardSupport != "" {
+			fmt.Fprintf(&b, "EndOfStandardSupport: %s,\n", strconv.Quote(s.EndOfStandardSupport))
+		}
+		if s.EndOfExtendedSupport != "" {
+			fmt.Fprintf(&b, "EndOfExtendedSupport: %s,\n", strconv.Quote(s.EndOfExtendedSupport))
+		}
+		writeSource(&b, s.Source)
+		b.WriteString("},\n")
+	}
+	b.WriteString("}")
+	return b.String()
+}
@@ -0,0 +1,82 @@
+package gkesync
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+	"github.com/abdulmajid3352/codecamp/pkg/rewrite"
+)
+
+func TestSyncSchedule(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "gke_lifecycle.go")
+	// 1.33 was recorded with an extended end the page now only estimates,
+	// and 1.27 no longer appears on the page.
+	src := `package project
+
+var GKEMinorSupport = []MinorSupport{
+	{
+		Minor:                "1.33",
+		EndOfExtendedSupport: "2027-06-30",
+		Source:               &Provenance{URL: "https://cloud.google.com/kubernetes-engine/docs/release-schedule"},
+	},
+	{
+		Minor:                "1.27",
+		EndOfStandardSupport: "2024-09-30",
+	},
+}
+`
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	opts := Options{
+		SupportFile: path,
+		HTMLFile:    "../gkenotes/testdata/release_schedule.html",
+		Log:         io.Discard,
+	}
+	res, err := SyncSchedule(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"1.34", "1.33", "1.32"}; !reflect.DeepEqual(res.Changed, want) {
+		t.Errorf("Changed = %v, want %v", res.Changed, want)
+	}
+
+	file, err := rewrite.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []project.MinorSupport
+	if err := file.Decode(supportVariable, &got, literalIdents()); err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{ minor, standard, extended string }{
+		{"1.34", "", ""},
+		{"1.33", "2026-07-31", "2027-06-30"},
+		{"1.32", "2026-02-28", "2027-02-28"},
+		{"1.27", "2024-09-30", ""},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("recorded %d minors, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.Minor != w.minor || g.EndOfStandardSupport != w.standard || g.EndOfExtendedSupport != w.extended {
+			t.Errorf("minor %d = %s %q %q, want %s %q %q", i, g.Minor, g.EndOfStandardSupport, g.EndOfExtendedSupport, w.minor, w.standard, w.extended)
+		}
+	}
+	if got[2].Source == nil || got[2].Source.ContentHash == "" {
+		t.Errorf("1.32 recorded without a source: %+v", got[2].Source)
+	}
+
+	// A second run finds nothing to change.
+	if res, err = SyncSchedule(opts); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Changed) != 0 {
+		t.Errorf("second run changed %v", res.Changed)
+	}
+}
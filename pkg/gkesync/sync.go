@@ -0,0 +1,271 @@
+// Package gkesync adds new GKE R releases to the GKEProjectReleases slice in
+// pkg/project/gke.go, or to its per-channel counterparts, from the GKE
+// release notes.
+//
+// It walks the "(YYYY-RXX) Version updates" sections newest first, stops at
+// the highest release already recorded, reads the Kubernetes versions from
+// each section's panel for the channel, Stable by default, and inserts one
+// entry per new release.
+package gkesync
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+	"github.com/abdulmajid3352/codecamp/pkg/gkenotes"
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+	"github.com/abdulmajid3352/codecamp/pkg/rewrite"
+	"github.com/abdulmajid3352/codecamp/pkg/scrape"
+)
+
+// projectExpr is the Project field of inserted entries.
+const projectExpr = "GKE.ID"
+
+// Options configures Run, CheckGaps, Backfill and SyncSchedule.
+type Options struct {
+	// GoFile is the path of the Go file holding the releases slice.
+	GoFile string
+	// Channel is the release channel to sync. Defaults to
+	// project.DefaultChannel.
+	Channel project.ReleaseChannel
+	// Variable is the releases slice to update. Defaults to the channel's
+	// slice, e.g. GKEProjectReleases for Stable.
+	Variable string
+	// DetailsFile is the Go file holding GKEReleaseDetails, where the full
+	// build versions of new releases are recorded. Builds are not recorded
+	// when empty.
+	DetailsFile string
+	// Project declares the ReleasePatterns versions are parsed with.
+	// Defaults to project.GKE.
+	Project *model.Project
+	// Config describes where the release notes live and how they are split
+	// into sections. Defaults to project.GKECurationConfig.
+	Config *model.ProjectCurationConfig
+	// HTMLFile is a saved copy of the release notes. When empty the pages
+	// named by Config are fetched.
+	HTMLFile string
+	// GapsFile is the Go file holding GKEReleaseGaps, where CheckGaps
+	// records its outcomes. Outcomes are not recorded when empty.
+	GapsFile string
+	// SupportFile is the Go file holding GKEMinorSupport, where
+	// SyncSchedule records end of support dates. For SyncSchedule,
+	// HTMLFile is a saved copy of the release schedule page.
+	SupportFile string
+	// From and To bound the releases Backfill processes, inclusive. Either
+	// may be empty to leave that end open.
+	From, To string
+	// Checkpoint is the file where Backfill records its progress. Progress
+	// is not recorded when empty.
+	Checkpoint string
+	// DryRun reports what would be added without writing GoFile.
+	DryRun bool
+	// Log receives the console summary. Defaults to os.Stdout.
+	Log io.Writer
+}
+
+func (opts *Options) setDefaults() {
+	if opts.Channel == "" {
+		opts.Channel = project.DefaultChannel
+	}
+	if opts.Variable == "" {
+		opts.Variable = opts.Channel.Variable()
+	}
+	if opts.Project == nil {
+		opts.Project = &project.GKE
+	}
+	if opts.Config == nil {
+		opts.Config = project.GKECurationConfig
+	}
+	if opts.Log == nil {
+		opts.Log = os.Stdout
+	}
+}
+
+// Added is a release inserted by Run.
+type Added struct {
+	Release string
+	Refs    []string
+}
+
+// Result summarizes a Run.
+type Result struct {
+	Channel         project.ReleaseChannel
+	HighestExisting string
+	Discovered      []string
+	Added           []Added
+	Skipped         []string
+}
+
+// Run discovers the releases newer than the highest one in opts.GoFile,
+// inserts them and prints a summary to opts.Log.
+func Run(opts Options) (*Result, error) {
+	opts.setDefaults()
+
+	parser, err := calver.ForProject(opts.Project)
+	if err != nil {
+		return nil, err
+	}
+	file, err := rewrite.Load(opts.GoFile)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := file.Releases(opts.Variable)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", opts.GoFile, err)
+	}
+	res := &Result{Channel: opts.Channel}
+	var highest calver.Version
+	for _, e := range existing {
+		if v, err := parser.Parse(e.Version); err == nil && highest.Less(v) {
+			highest = v
+			res.HighestExisting = e.Version
+		}
+	}
+
+	sections, fetchedAt, err := loadSections(opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(sections) == 0 {
+		return nil, fmt.Errorf("no release sections found; the release notes layout may have changed")
+	}
+
+	recorded, err := loadDetails(opts.DetailsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []rewrite.Release
+	var details []project.ReleaseDetail
+	lastOK := res.HighestExisting
+	for _, s := range sections {
+		v, err := parser.Parse(s.Key)
+		if err != nil {
+			return res, fmt.Errorf("unexpected release after %s: %w", lastOK, err)
+		}
+		if !highest.Less(v) {
+			break
+		}
+		res.Discovered = append(res.Discovered, s.Key)
+		panel, err := gkenotes.ExtractChannel(s, opts.Channel)
+		var noTab *gkenotes.NoChannelTabError
+		if errors.As(err, &noTab) {
+			fmt.Fprintln(opts.Log, noTab)
+			res.Skipped = append(res.Skipped, s.Key)
+			continue
+		} else if err != nil {
+			return res, fmt.Errorf("extracting %s (last successful release %s): %w", s.Key, lastOK, err)
+		}
+		refs := kubever.SortedRefs(panel.Kube())
+		entries = append(entries, rewrite.Release{
+			Project:                projectExpr,
+			Version:                s.Key,
+			RelatedProjectReleases: refs,
+		})
+		source, err := provenance(s, fetchedAt)
+		if err != nil {
+			return res, fmt.Errorf("extracting %s (last successful release %s): %w", s.Key, lastOK, err)
+		}
+		details = append(details, panelDetail(detailOf(parser, recorded, s.Key), s, source, panel))
+		res.Added = append(res.Added, Added{Release: s.Key, Refs: refs})
+		lastOK = s.Key
+	}
+
+	if len(res.Discovered) == 0 {
+		fmt.Fprintln(opts.Log, "No new releases")
+		return res, nil
+	}
+	if len(entries) > 0 && !opts.DryRun {
+		for _, e := range entries {
+			if _, err := file.Upsert(parser, opts.Variable, e); err != nil {
+				return res, fmt.Errorf("updating %s (last successful release %s): %w", opts.GoFile, lastOK, err)
+			}
+		}
+		if err := file.Save(opts.GoFile); err != nil {
+			return res, err
+		}
+		if err := saveDetails(parser, opts.DetailsFile, details); err != nil {
+			return res, err
+		}
+	}
+	res.print(opts.Log)
+	return res, nil
+}
+
+func (res *Result) print(w io.Writer) {
+	fmt.Fprintf(w, "Channel: %s\n", res.Channel)
+	highest := res.HighestExisting
+	if highest == "" {
+		highest = "none"
+	}
+	fmt.Fprintf(w, "HIGHEST_EXISTING: %s\n", highest)
+	fmt.Fprintf(w, "Discovered sections: %d\n", len(res.Discovered))
+	fmt.Fprintf(w, "Added: %d\n", len(res.Added))
+	for _, a := range res.Added {
+		fmt.Fprintf(w, "  %s: %d versions\n", a.Release, len(a.Refs))
+	}
+}
+
+func saveDetails(parser *calver.Parser, path string, details []project.ReleaseDetail) error {
+	if path == "" {
+		return nil
+	}
+	file, err := rewrite.Load(path)
+	if err != nil {
+		return err
+	}
+	for _, d := range details {
+		if _, err := file.Put(parser, detailsVariable, d.Version, detailLiteral(d)); err != nil {
+			return fmt.Errorf("recording %s builds: %w", d.Version, err)
+		}
+	}
+	return file.Save(path)
+}
+
+// loadSections scrapes the release notes and returns when they were read:
+// now, or the modification time of a saved copy.
+func loadSections(opts Options) ([]*scrape.Section, time.Time, error) {
+	engine := &scrape.Engine{}
+	fetchedAt := time.Now()
+	if opts.HTMLFile != "" {
+		info, err := os.Stat(opts.HTMLFile)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		engine.Fetch = scrape.Snapshot(opts.HTMLFile)
+		fetchedAt = info.ModTime()
+	}
+	sections, err := engine.Scrape(opts.Config)
+	return sections, fetchedAt.UTC().Truncate(time.Second), err
+}
+
+// provenance records where s was read from. Headings without an id fall
+// back to the anchor the release notes generate for "(YYYY-RXX) Version
+// updates" headings.
+func provenance(s *scrape.Section, fetchedAt time.Time) (*project.Provenance, error) {
+	body, err := s.HTML()
+	if err != nil {
+		return nil, err
+	}
+	anchor := s.Anchor
+	if anchor == "" {
+		anchor = strings.ToLower(s.Key) + "_version_updates"
+	}
+	sum := sha256.Sum256([]byte(body))
+	return &project.Provenance{
+		URL:         s.Source.LinkTemplate.URLTemplate,
+		Anchor:      anchor,
+		FetchedAt:   fetchedAt.Format(time.RFC3339),
+		ContentHash: "sha256:" + hex.EncodeToString(sum[:]),
+	}, nil
+}
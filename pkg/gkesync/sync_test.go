@@ -0,0 +1,124 @@
+package gkesync
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/abdulmajid3352/codecamp/pkg/project"
+	"github.com/abdulmajid3352/codecamp/pkg/rewrite"
+)
+
+// writeFile writes src to name in dir and returns its path.
+func writeFile(t *testing.T, dir, name, src string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+const releasesSrc = `package project
+
+var GKEProjectReleases = []model.ProjectRelease{
+	{
+		Project: GKE.ID,
+		Version: "2025-R37",
+		RelatedProjectReleases: []string{
+			"kube@1.32.6",
+		},
+	},
+}
+`
+
+const detailsSrc = `package project
+
+var GKEReleaseDetails = []ReleaseDetail{
+	{
+		Version: "2025-R37",
+	},
+}
+`
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	opts := Options{
+		GoFile:      writeFile(t, dir, "gke.go", releasesSrc),
+		DetailsFile: writeFile(t, dir, "gke_release_details.go", detailsSrc),
+		HTMLFile:    "testdata/release_notes.html",
+		Log:         io.Discard,
+	}
+	res, err := Run(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.HighestExisting != "2025-R37" || !reflect.DeepEqual(res.Discovered, []string{"2025-R39", "2025-R38"}) {
+		t.Errorf("Run discovered %v above %s, want [2025-R39 2025-R38] above 2025-R37", res.Discovered, res.HighestExisting)
+	}
+
+	file, err := rewrite.Load(opts.GoFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := file.Releases("GKEProjectReleases")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []rewrite.Release{
+		{Version: "2025-R39", RelatedProjectReleases: []string{"kube@1.30.12", "kube@1.31.12", "kube@1.32.7", "kube@1.32.8"}},
+		{Version: "2025-R38", RelatedProjectReleases: []string{"kube@1.31.11"}},
+		{Version: "2025-R37", RelatedProjectReleases: []string{"kube@1.32.6"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GKEProjectReleases = %+v\nwant %+v", got, want)
+	}
+	src, err := os.ReadFile(opts.GoFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(src), "Project: "+projectExpr+","); n != 3 {
+		t.Errorf("%d entries have Project %s, want 3:\n%s", n, projectExpr, src)
+	}
+
+	details, err := loadDetails(opts.DetailsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(details) != 3 || details[0].Version != "2025-R39" {
+		t.Fatalf("GKEReleaseDetails = %+v, want 2025-R39, R38 and R37", details)
+	}
+	r39 := details[0]
+	if r39.Published != "2025-10-01" || r39.Source == nil || r39.Source.Anchor != "2025-r39_version_updates" || r39.Source.ContentHash == "" {
+		t.Errorf("2025-R39 recorded as published %q from %+v", r39.Published, r39.Source)
+	}
+	wantBuilds := []project.ReleaseBuild{
+		{Channel: project.ChannelStable, Role: project.RoleRemoved, Version: "1.30.12-gke.1279000"},
+		{Channel: project.ChannelStable, Role: project.RoleAvailable, Version: "1.31.12-gke.1265000"},
+		{Channel: project.ChannelStable, Role: project.RoleDefault, Version: "1.32.7-gke.1079000"},
+		{Channel: project.ChannelStable, Role: project.RoleAvailable, Version: "1.32.8-gke.1134000"},
+	}
+	if !reflect.DeepEqual(r39.Builds, wantBuilds) {
+		t.Errorf("2025-R39 builds = %+v\nwant %+v", r39.Builds, wantBuilds)
+	}
+
+	// A second run finds the releases recorded and leaves the files as
+	// they are.
+	before, err := os.ReadFile(opts.GoFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, err = Run(opts); err != nil {
+		t.Fatal(err)
+	}
+	after, err := os.ReadFile(opts.GoFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Discovered) != 0 || string(after) != string(before) {
+		t.Errorf("second run discovered %v and changed the releases file", res.Discovered)
+	}
+}
@@ -0,0 +1,102 @@
+package kubever
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// buildPattern matches a full GKE version such as "1.31.9-gke.1044000",
+// "1.30.5-autopilot.1" or "1.29.6-gke.1326000+cos".
+var buildPattern = regexp.MustCompile(`^(\d+\.\d+\.\d+)(?:-gke\.(\d+))?(?:-autopilot\.(\d+))?(?:\+([0-9A-Za-z.\-]+))?$`)
+
+// Build is a full GKE version: a Kubernetes version plus the GKE build
+// number and optional Autopilot revision and build metadata.
+type Build struct {
+	Kube      Version
+	GKE       int
+	Autopilot int
+	Metadata  string
+	raw       string
+}
+
+// ParseBuild parses a full GKE version. A plain "x.y.z" is accepted as a
+// build without GKE suffixes.
+func ParseBuild(s string) (Build, error) {
+	m := buildPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Build{}, fmt.Errorf("invalid GKE version %q", s)
+	}
+	kube, err := Parse(m[1])
+	if err != nil {
+		return Build{}, err
+	}
+	b := Build{Kube: kube, Metadata: m[4], raw: s}
+	if m[2] != "" {
+		b.GKE, _ = strconv.Atoi(m[2])
+	}
+	if m[3] != "" {
+		b.Autopilot, _ = strconv.Atoi(m[3])
+	}
+	return b, nil
+}
+
+// String returns the build as it was parsed.
+func (b Build) String() string {
+	if b.raw != "" {
+		return b.raw
+	}
+	var s strings.Builder
+	s.WriteString(b.Kube.String())
+	if b.GKE > 0 {
+		fmt.Fprintf(&s, "-gke.%d", b.GKE)
+	}
+	if b.Autopilot > 0 {
+		fmt.Fprintf(&s, "-autopilot.%d", b.Autopilot)
+	}
+	if b.Metadata != "" {
+		s.WriteString("+" + b.Metadata)
+	}
+	return s.String()
+}
+
+// Ref returns the normalized "kube@x.y.z" reference of the build.
+func (b Build) Ref() string {
+	return b.Kube.Ref()
+}
+
+// Compare orders builds by Kubernetes version, then by GKE build number,
+// then by Autopilot revision. "1.31.9-gke.1044000" sorts after
+// "1.31.9-gke.999000" although it sorts before it as a string. Build
+// metadata only breaks ties.
+func (b Build) Compare(o Build) int {
+	if c := b.Kube.Compare(o.Kube); c != 0 {
+		return c
+	}
+	if c := cmpInt(b.GKE, o.GKE); c != 0 {
+		return c
+	}
+	if c := cmpInt(b.Autopilot, o.Autopilot); c != 0 {
+		return c
+	}
+	return strings.Compare(b.Metadata, o.Metadata)
+}
+
+// Less reports whether b sorts before o.
+func (b Build) Less(o Build) bool {
+	return b.Compare(o) < 0
+}
+
+// CompareBuilds compares two full GKE version strings.
+func CompareBuilds(a, b string) (int, error) {
+	ba, err := ParseBuild(a)
+	if err != nil {
+		return 0, err
+	}
+	bb, err := ParseBuild(b)
+	if err != nil {
+		return 0, err
+	}
+	return ba.Compare(bb), nil
+}
@@ -0,0 +1,108 @@
+package kubever
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestParseBuild(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Build
+		wantErr bool
+	}{
+		{in: "1.30.5-gke.1014001", want: Build{Kube: Version{1, 30, 5}, GKE: 1014001}},
+		{in: "1.31.9-gke.1044000", want: Build{Kube: Version{1, 31, 9}, GKE: 1044000}},
+		{in: "1.30.5-autopilot.1", want: Build{Kube: Version{1, 30, 5}, Autopilot: 1}},
+		{in: "1.29.6-gke.1326000+cos", want: Build{Kube: Version{1, 29, 6}, GKE: 1326000, Metadata: "cos"}},
+		{in: "1.29.6-gke.1326000-autopilot.2", want: Build{Kube: Version{1, 29, 6}, GKE: 1326000, Autopilot: 2}},
+		{in: "1.31.9", want: Build{Kube: Version{1, 31, 9}}},
+		{in: "1.31", wantErr: true},
+		{in: "v1.31.9-gke.1044000", wantErr: true},
+		{in: "1.31.9-gke.", wantErr: true},
+		{in: "1.31.9-eks.1", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseBuild(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseBuild(%q) = %v, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseBuild(%q): %v", tt.in, err)
+			continue
+		}
+		tt.want.raw = tt.in
+		if got != tt.want {
+			t.Errorf("ParseBuild(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+		if got.String() != tt.in {
+			t.Errorf("ParseBuild(%q).String() = %q", tt.in, got.String())
+		}
+		if got.Ref() != tt.want.Kube.Ref() {
+			t.Errorf("ParseBuild(%q).Ref() = %q, want %q", tt.in, got.Ref(), tt.want.Kube.Ref())
+		}
+	}
+}
+
+func TestBuildString(t *testing.T) {
+	b := Build{Kube: Version{1, 29, 6}, GKE: 1326000, Autopilot: 2, Metadata: "cos"}
+	if got, want := b.String(), "1.29.6-gke.1326000-autopilot.2+cos"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestCompareBuilds(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.30.5-gke.1014001", "1.30.5-gke.1014001", 0},
+		// The gke suffix compares as a number, not as a string.
+		{"1.31.9-gke.999000", "1.31.9-gke.1044000", -1},
+		{"1.30.5-gke.1014001", "1.30.5-gke.1014000", 1},
+		// The Kubernetes version decides before the gke suffix.
+		{"1.30.10-gke.1000", "1.30.9-gke.2000000", 1},
+		{"1.29.15-gke.9000000", "1.30.1-gke.1000", -1},
+		{"1.30.5", "1.30.5-gke.1", -1},
+		{"1.30.5-gke.1014001", "1.30.5-gke.1014001-autopilot.1", -1},
+		{"1.29.6-gke.1326000", "1.29.6-gke.1326000+cos", -1},
+	}
+	for _, tt := range tests {
+		got, err := CompareBuilds(tt.a, tt.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("CompareBuilds(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if back, _ := CompareBuilds(tt.b, tt.a); back != -tt.want {
+			t.Errorf("CompareBuilds(%q, %q) = %d, want %d", tt.b, tt.a, back, -tt.want)
+		}
+	}
+	if _, err := CompareBuilds("1.30.5-gke.1", "latest"); err == nil {
+		t.Error("CompareBuilds with an invalid version succeeded")
+	}
+}
+
+func TestSortBuilds(t *testing.T) {
+	in := []string{"1.31.9-gke.1044000", "1.30.5-gke.1014001", "1.31.9-gke.999000", "1.31.10-gke.1000", "1.30.5-gke.1014000"}
+	builds := make([]Build, len(in))
+	for i, s := range in {
+		b, err := ParseBuild(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		builds[i] = b
+	}
+	sort.Slice(builds, func(i, j int) bool { return builds[i].Less(builds[j]) })
+	want := []string{"1.30.5-gke.1014000", "1.30.5-gke.1014001", "1.31.9-gke.999000", "1.31.9-gke.1044000", "1.31.10-gke.1000"}
+	for i, b := range builds {
+		if b.String() != want[i] {
+			t.Errorf("sorted[%d] = %s, want %s", i, b, want[i])
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Package kubever parses and orders the Kubernetes versions referenced by
+// project releases, e.g. "kube@1.31.9".
+package kubever
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// RefPrefix is the prefix used by RelatedProjectReleases entries that point
+// at a Kubernetes release.
+const RefPrefix = "kube@"
+
+var (
+	semverPattern = regexp.MustCompile(`\b(\d+)\.(\d+)\.(\d+)\b`)
+	exactPattern  = regexp.MustCompile(`^(\d+)\.(\d+)\.(\d+)$`)
+)
+
+// Version is a Kubernetes major.minor.patch version.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// Parse parses a plain "x.y.z" version.
+func Parse(s string) (Version, error) {
+	m := exactPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Version{}, fmt.Errorf("invalid kube version %q", s)
+	}
+	return fromMatch(m), nil
+}
+
+// ParseRef parses a "kube@x.y.z" reference.
+func ParseRef(ref string) (Version, error) {
+	if !strings.HasPrefix(ref, RefPrefix) {
+		return Version{}, fmt.Errorf("invalid kube reference %q: missing %q prefix", ref, RefPrefix)
+	}
+	v, err := Parse(strings.TrimPrefix(ref, RefPrefix))
+	if err != nil {
+		return Version{}, fmt.Errorf("invalid kube reference %q: %w", ref, err)
+	}
+	return v, nil
+}
+
+// Extract returns every x.y.z version found in s, in order of appearance.
+// Provider suffixes such as "-gke.1044000" or "+cos" are ignored.
+func Extract(s string) []Version {
+	var out []Version
+	for _, m := range semverPattern.FindAllStringSubmatch(s, -1) {
+		out = append(out, fromMatch(m))
+	}
+	return out
+}
+
+// String returns the version as "x.y.z".
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Ref returns the version as a "kube@x.y.z" reference.
+func (v Version) Ref() string {
+	return RefPrefix + v.String()
+}
+
+// Compare returns -1, 0 or 1 depending on whether v sorts before, equal to
+// or after o.
+func (v Version) Compare(o Version) int {
+	switch {
+	case v.Major != o.Major:
+		return cmpInt(v.Major, o.Major)
+	case v.Minor != o.Minor:
+		return cmpInt(v.Minor, o.Minor)
+	default:
+		return cmpInt(v.Patch, o.Patch)
+	}
+}
+
+// Less reports whether v sorts before o.
+func (v Version) Less(o Version) bool {
+	return v.Compare(o) < 0
+}
+
+// SortedRefs de-duplicates vs and returns them as "kube@x.y.z" references
+// sorted ascending by major, minor and patch.
+func SortedRefs(vs []Version) []string {
+	uniq := make(map[Version]struct{}, len(vs))
+	sorted := make([]Version, 0, len(vs))
+	for _, v := range vs {
+		if _, ok := uniq[v]; ok {
+			continue
+		}
+		uniq[v] = struct{}{}
+		sorted = append(sorted, v)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Less(sorted[j]) })
+	refs := make([]string, len(sorted))
+	for i, v := range sorted {
+		refs[i] = v.Ref()
+	}
+	return refs
+}
+
+func fromMatch(m []string) Version {
+	major, _ := strconv.Atoi(m[1])
+	minor, _ := strconv.Atoi(m[2])
+	patch, _ := strconv.Atoi(m[3])
+	return Version{Major: major, Minor: minor, Patch: patch}
+}
+
+func cmpInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,94 @@
+package kubever
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var minorPattern = regexp.MustCompile(`^(\d+)\.(\d+)$`)
+
+// Minor is a Kubernetes major.minor line, e.g. 1.30.
+type Minor struct {
+	Major int
+	Minor int
+}
+
+// ParseMinor parses a plain "x.y" minor.
+func ParseMinor(s string) (Minor, error) {
+	m := minorPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Minor{}, fmt.Errorf("invalid kube minor %q", s)
+	}
+	major, _ := strconv.Atoi(m[1])
+	minor, _ := strconv.Atoi(m[2])
+	return Minor{Major: major, Minor: minor}, nil
+}
+
+// ParseMinorRef parses a "kube@x.y" reference.
+func ParseMinorRef(ref string) (Minor, error) {
+	if !strings.HasPrefix(ref, RefPrefix) {
+		return Minor{}, fmt.Errorf("invalid kube reference %q: missing %q prefix", ref, RefPrefix)
+	}
+	m, err := ParseMinor(strings.TrimPrefix(ref, RefPrefix))
+	if err != nil {
+		return Minor{}, fmt.Errorf("invalid kube reference %q: %w", ref, err)
+	}
+	return m, nil
+}
+
+// Line returns the minor v belongs to.
+func (v Version) Line() Minor {
+	return Minor{Major: v.Major, Minor: v.Minor}
+}
+
+// String returns the minor as "x.y".
+func (m Minor) String() string {
+	return fmt.Sprintf("%d.%d", m.Major, m.Minor)
+}
+
+// Ref returns the minor as a "kube@x.y" reference.
+func (m Minor) Ref() string {
+	return RefPrefix + m.String()
+}
+
+// Next returns the minor after m within the same major.
+func (m Minor) Next() Minor {
+	return Minor{Major: m.Major, Minor: m.Minor + 1}
+}
+
+// Compare returns -1, 0 or 1 depending on whether m sorts before, equal to
+// or after o.
+func (m Minor) Compare(o Minor) int {
+	if m.Major != o.Major {
+		return cmpInt(m.Major, o.Major)
+	}
+	return cmpInt(m.Minor, o.Minor)
+}
+
+// Less reports whether m sorts before o.
+func (m Minor) Less(o Minor) bool {
+	return m.Compare(o) < 0
+}
+
+// MinorRefs de-duplicates ms and returns them as "kube@x.y" references
+// sorted ascending.
+func MinorRefs(ms []Minor) []string {
+	uniq := make(map[Minor]struct{}, len(ms))
+	sorted := make([]Minor, 0, len(ms))
+	for _, m := range ms {
+		if _, ok := uniq[m]; ok {
+			continue
+		}
+		uniq[m] = struct{}{}
+		sorted = append(sorted, m)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Less(sorted[j]) })
+	refs := make([]string, len(sorted))
+	for i, m := range sorted {
+		refs[i] = m.Ref()
+	}
+	return refs
+}
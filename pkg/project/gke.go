@@ -139,7 +139,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.32.4",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r29_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R27",
@@ -149,7 +148,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.32.4",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r27_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R26",
@@ -159,7 +157,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.31.9",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r26_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R25",
@@ -170,7 +167,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.31.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r25_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R24",
@@ -181,7 +177,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.31.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r24_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R22",
@@ -190,7 +185,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.31.7",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r22_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R20",
@@ -202,7 +196,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.32.2",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r20_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R19",
@@ -212,13 +205,11 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.32.2",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r19_version_updates
 	{
 		Project:                GKE.ID,
 		Version:                "2025-R18",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r18_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R17",
@@ -228,7 +219,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.32.2",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r17_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R16",
@@ -237,7 +227,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.32.2",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r16_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R15",
@@ -250,19 +239,16 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.32.2",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r15_version_updates
 	{
 		Project:                GKE.ID,
 		Version:                "2025-R14",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r14_version_updates
 	{
 		Project:                GKE.ID,
 		Version:                "2025-R13",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r13_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R12",
@@ -272,7 +258,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.32.2",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r12_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R11",
@@ -281,7 +266,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.31.6",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r11_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R10",
@@ -291,7 +275,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.31.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r10_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R09",
@@ -302,7 +285,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.32.1",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r09_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R08",
@@ -315,7 +297,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.31.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r08_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R07",
@@ -328,7 +309,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.31.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r07_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R06",
@@ -339,7 +319,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.31.4",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r06_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R05",
@@ -350,7 +329,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.31.4",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r05_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R04",
@@ -363,7 +341,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.31.4",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r04_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R03",
@@ -374,7 +351,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.30.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r03_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R02",
@@ -384,7 +360,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.30.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r02_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2025-R01",
@@ -395,19 +370,16 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.30.6",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2025-r01_version_updates
 	{
 		Project:                GKE.ID,
 		Version:                "2024-R50",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r50_version_updates
 	{
 		Project:                GKE.ID,
 		Version:                "2024-R49",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r49_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R48",
@@ -419,7 +391,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.30.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r48_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R47",
@@ -431,19 +402,16 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.30.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r47_version_updates
 	{
 		Project:                GKE.ID,
 		Version:                "2024-R46",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r46_version_updates
 	{
 		Project:                GKE.ID,
 		Version:                "2024-R45",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r45_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R44",
@@ -453,13 +421,11 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.30.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r44_version_updates
 	{
 		Project:                GKE.ID,
 		Version:                "2024-R43",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r43_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R42",
@@ -470,7 +436,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.30.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r42_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R41",
@@ -483,7 +448,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.30.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r41_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R40",
@@ -496,7 +460,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.30.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r40_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R39",
@@ -508,7 +471,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.30.4",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r39_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R38",
@@ -519,7 +481,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.30.3",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r38_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R36",
@@ -529,7 +490,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.30.3",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r36_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R35",
@@ -541,7 +501,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.30.3",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r35_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R32",
@@ -551,7 +510,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.29.7",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r32_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R27",
@@ -563,7 +521,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.29.6",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r27_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R25",
@@ -576,7 +533,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.29.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r25_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R23",
@@ -587,7 +543,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.29.4",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r23_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R21",
@@ -597,7 +552,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.28.9",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r21_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R20",
@@ -609,7 +563,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.28.9",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r20_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R19",
@@ -620,7 +573,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.29.4",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r19_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R18",
@@ -632,7 +584,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.28.9",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r18_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R16",
@@ -641,7 +592,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.28.7",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r16_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R14",
@@ -655,7 +605,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.28.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r14_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R13",
@@ -668,7 +617,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.28.7",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r13_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R12",
@@ -677,7 +625,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.27.11",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r12_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R11",
@@ -685,7 +632,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.25.16",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r11_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R10",
@@ -701,13 +647,11 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.28.7",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r10_version_updates
 	{
 		Project:                GKE.ID,
 		Version:                "2024-R09",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r09_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R08",
@@ -717,7 +661,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.26.13",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#March_20_2024
 	{
 		Project: GKE.ID,
 		Version: "2024-R07",
@@ -725,7 +668,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.26.11",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#March_07_2024
 	{
 		Project: GKE.ID,
 		Version: "2024-R06",
@@ -733,19 +675,16 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.27.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#March_04_2024
 	{
 		Project:                GKE.ID,
 		Version:                "2024-R05",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r05_version_updates
 	{
 		Project:                GKE.ID,
 		Version:                "2024-R04",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r04_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R03",
@@ -755,7 +694,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.28.3",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r03_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R02",
@@ -774,7 +712,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.28.3",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r02_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2024-R01",
@@ -791,13 +728,11 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.28.3",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2024-r01_version_updates
 	{
 		Project:                GKE.ID,
 		Version:                "2023-R26",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r26_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R25",
@@ -813,13 +748,11 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.27.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r25_version_updates
 	{
 		Project:                GKE.ID,
 		Version:                "2023-R24",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r24_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R23",
@@ -829,19 +762,16 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.26.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r23_version_updates
 	{
 		Project:                GKE.ID,
 		Version:                "2023-R22",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r22_version_updates
 	{
 		Project:                GKE.ID,
 		Version:                "2023-R20",
 		RelatedProjectReleases: []string{},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r20_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R19",
@@ -849,7 +779,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.26.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r19_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R18",
@@ -864,7 +793,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.27.4",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r18_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R17",
@@ -878,7 +806,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.27.3",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r17_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R16",
@@ -894,7 +821,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.27.3",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r16_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R15",
@@ -910,7 +836,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.27.2",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r15_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R14",
@@ -922,7 +847,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.25.9",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r14_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R13",
@@ -933,7 +857,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.26.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r13_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R12",
@@ -942,7 +865,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.25.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r12_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R11",
@@ -954,7 +876,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.25.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r11_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R10",
@@ -969,7 +890,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.25.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r10_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R09",
@@ -978,7 +898,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.24.11",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r09_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R08",
@@ -989,7 +908,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.24.10",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r08_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R07",
@@ -999,7 +917,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.24.10",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r07_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R06",
@@ -1010,7 +927,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.24.9",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r06_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R05",
@@ -1020,7 +936,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.24.9",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r05_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R04",
@@ -1032,7 +947,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.23.14",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r04_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R03",
@@ -1042,7 +956,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.24.9",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r03_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R02",
@@ -1050,7 +963,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.23.14",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r02_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2023-R01",
@@ -1063,7 +975,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.24.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2023-r01_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R28",
@@ -1071,7 +982,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.23.13",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r28_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R27",
@@ -1084,7 +994,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.24.7",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r27_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R26",
@@ -1093,7 +1002,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.24.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r26_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R25",
@@ -1105,7 +1013,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.23.11",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r25_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R24",
@@ -1113,7 +1020,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.21.14",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r24_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R23",
@@ -1122,7 +1028,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.22.12",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r23_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R22",
@@ -1133,7 +1038,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.22.12",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r22_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R21",
@@ -1146,7 +1050,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.23.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r21_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R20",
@@ -1155,7 +1058,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.21.13",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r20_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R19",
@@ -1166,7 +1068,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.22.10",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r19_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R18",
@@ -1180,7 +1081,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.23.7",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r18_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R17",
@@ -1190,7 +1090,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.23.6",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r17_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R16",
@@ -1201,7 +1100,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.21.12",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r16_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R15",
@@ -1212,7 +1110,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.21.12",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r15_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R14",
@@ -1221,7 +1118,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.22.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r14_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R13",
@@ -1232,7 +1128,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.22.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r13_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R12",
@@ -1244,7 +1139,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.22.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r12_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R11",
@@ -1254,7 +1148,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.21.11",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r11_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R10",
@@ -1265,7 +1158,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.22.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r10_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R9",
@@ -1276,7 +1168,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.22.8",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r9_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R8",
@@ -1287,7 +1178,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.21.10",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r8_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R7",
@@ -1297,7 +1187,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.20.15",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r7_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R6",
@@ -1305,7 +1194,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.20.15",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r6_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R5",
@@ -1315,7 +1203,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.20.15",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r5_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R4",
@@ -1326,7 +1213,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.21.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r4_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R3",
@@ -1336,7 +1222,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.21.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r3_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R02",
@@ -1344,7 +1229,6 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.19.16",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r02_version_updates
 	{
 		Project: GKE.ID,
 		Version: "2022-R01",
@@ -1353,11 +1237,16 @@ var GKEProjectReleases = []model.ProjectRelease{
 			"kube@1.21.5",
 		},
 	},
-	// source: https://cloud.google.com/kubernetes-engine/docs/release-notes#2022-r01_version_updates
 }
 
 func init() {
 	RegisterProject(&GKE)
 	RegisterProjectReleases(GKE.ID, GKEProjectReleases)
+	RegisterChannelReleases(GKE.ID, ChannelRapid, GKERapidProjectReleases)
+	RegisterChannelReleases(GKE.ID, ChannelRegular, GKERegularProjectReleases)
+	RegisterChannelReleases(GKE.ID, ChannelExtended, GKEExtendedProjectReleases)
+	RegisterReleaseDetails(GKE.ID, GKEReleaseDetails)
+	RegisterReleaseGaps(GKE.ID, GKEReleaseGaps)
+	RegisterMinorSupport(GKE.ID, GKEMinorSupport)
 	RegisterCurationConfig(GKE.ID, GKECurationConfig)
 }
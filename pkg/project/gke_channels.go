@@ -0,0 +1,102 @@
+package project
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+)
+
+// ReleaseChannel is a GKE release channel.
+type ReleaseChannel string
+
+const (
+	ChannelRapid    ReleaseChannel = "Rapid"
+	ChannelRegular  ReleaseChannel = "Regular"
+	ChannelStable   ReleaseChannel = "Stable"
+	ChannelExtended ReleaseChannel = "Extended"
+	// ChannelNone is used by the release notes for clusters not enrolled
+	// in a release channel. It is recognized but not recorded.
+	ChannelNone ReleaseChannel = "No channel"
+)
+
+// DefaultChannel is the channel recorded in GKEProjectReleases and used
+// whenever no channel is given.
+const DefaultChannel = ChannelStable
+
+// ReleaseChannels lists the recorded channels from fastest to slowest.
+var ReleaseChannels = []ReleaseChannel{ChannelRapid, ChannelRegular, ChannelStable, ChannelExtended}
+
+// ParseReleaseChannel parses a channel name case-insensitively. The empty
+// string is DefaultChannel.
+func ParseReleaseChannel(s string) (ReleaseChannel, error) {
+	if s == "" {
+		return DefaultChannel, nil
+	}
+	for _, c := range ReleaseChannels {
+		if strings.EqualFold(s, string(c)) {
+			return c, nil
+		}
+	}
+	return "", fmt.Errorf("unknown release channel %q", s)
+}
+
+// Variable returns the name of the releases slice holding the channel:
+// GKEProjectReleases for Stable and GKE<Channel>ProjectReleases otherwise.
+func (c ReleaseChannel) Variable() string {
+	if c == ChannelStable {
+		return "GKEProjectReleases"
+	}
+	return "GKE" + string(c) + "ProjectReleases"
+}
+
+// The Rapid, Regular and Extended channel counterparts of
+// GKEProjectReleases, which holds Stable. Each entry lists the Kubernetes
+// versions the channel's panel mentions in that R release. They are
+// maintained with gke-sync -channel and follow the same conventions.
+
+var GKERapidProjectReleases = []model.ProjectRelease{}
+
+var GKERegularProjectReleases = []model.ProjectRelease{}
+
+var GKEExtendedProjectReleases = []model.ProjectRelease{}
+
+var gkeParser = calver.MustParser(GKE.Versioning.ReleasePatterns)
+
+// GKEReleases returns the GKE releases of channel, newest first.
+func GKEReleases(channel ReleaseChannel) ([]model.ProjectRelease, error) {
+	switch channel {
+	case "", ChannelStable:
+		return GKEProjectReleases, nil
+	case ChannelRapid:
+		return GKERapidProjectReleases, nil
+	case ChannelRegular:
+		return GKERegularProjectReleases, nil
+	case ChannelExtended:
+		return GKEExtendedProjectReleases, nil
+	}
+	return nil, fmt.Errorf("unknown release channel %q", channel)
+}
+
+// GKERelease returns the GKE release version, padded or not, of channel.
+func GKERelease(version string, channel ReleaseChannel) (*model.ProjectRelease, error) {
+	if channel == "" {
+		channel = DefaultChannel
+	}
+	releases, err := GKEReleases(channel)
+	if err != nil {
+		return nil, err
+	}
+	want, err := gkeParser.Parse(version)
+	if err != nil {
+		return nil, err
+	}
+	for i := range releases {
+		if v, err := gkeParser.Parse(releases[i].Version); err == nil && v == want {
+			return &releases[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no GKE release %s in the %s channel", version, channel)
+}
@@ -0,0 +1,374 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+)
+
+// VersionRole is the group a version is listed under in a release.
+type VersionRole string
+
+const (
+	// RoleUnlabelled marks versions mentioned before any group heading.
+	RoleUnlabelled VersionRole = ""
+	// RoleDefault marks the default version for new clusters, control
+	// planes or nodes.
+	RoleDefault VersionRole = "default"
+	// RoleAvailable marks newly available versions.
+	RoleAvailable VersionRole = "available"
+	// RoleRemoved marks versions that are no longer available.
+	RoleRemoved VersionRole = "removed"
+)
+
+// ReleaseBuild is a full GKE version listed by a release channel, e.g.
+// "1.31.9-gke.1044000", with the role it has in that release. Its normalized
+// kube@x.y.z reference is the one recorded in the channel's
+// RelatedProjectReleases.
+type ReleaseBuild struct {
+	Channel ReleaseChannel
+	Role    VersionRole
+	Version string
+}
+
+// Kube returns the normalized "kube@x.y.z" reference of the build.
+func (b ReleaseBuild) Kube() (string, error) {
+	build, err := kubever.ParseBuild(b.Version)
+	if err != nil {
+		return "", err
+	}
+	return build.Ref(), nil
+}
+
+// Provenance records where the data of a release was curated from.
+type Provenance struct {
+	// URL is the release notes page, from the curation config's
+	// LinkTemplate.
+	URL string
+	// Anchor is the id of the release's section heading, e.g.
+	// "2025-r29_version_updates".
+	Anchor string
+	// FetchedAt is the RFC 3339 time the page was read, if known.
+	FetchedAt string
+	// ContentHash is "sha256:" followed by the hex digest of the section's
+	// HTML, if known.
+	ContentHash string
+}
+
+// Link returns the URL of the release's section.
+func (p *Provenance) Link() string {
+	if p.Anchor == "" {
+		return p.URL
+	}
+	return p.URL + "#" + p.Anchor
+}
+
+// Fetched returns FetchedAt as a time, or the zero time when unknown.
+func (p *Provenance) Fetched() (time.Time, error) {
+	if p.FetchedAt == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, p.FetchedAt)
+}
+
+// DateLayout is the layout of the dates recorded in a ReleaseDetail.
+const DateLayout = "2006-01-02"
+
+// ReleaseDetail holds the release notes data of a GKE R release that
+// model.ProjectRelease has no field for.
+type ReleaseDetail struct {
+	Version string
+	Source  *Provenance
+	// Published is the date the release notes list the release under, in
+	// DateLayout, if known.
+	Published string
+	// RolloutStart and RolloutComplete bound the rollout of the release
+	// across regions, in DateLayout, when the notes or curators give them.
+	RolloutStart    string
+	RolloutComplete string
+	Builds          []ReleaseBuild
+}
+
+// PublishedOn returns Published as a time, or the zero time when unknown.
+func (d *ReleaseDetail) PublishedOn() (time.Time, error) {
+	return parseDate(d.Published)
+}
+
+// Rollout returns the rollout start and completion dates, each the zero time
+// when unknown.
+func (d *ReleaseDetail) Rollout() (start, complete time.Time, err error) {
+	if start, err = parseDate(d.RolloutStart); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if complete, err = parseDate(d.RolloutComplete); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, complete, nil
+}
+
+// parseDate parses a date in DateLayout, or returns the zero time for "".
+func parseDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(DateLayout, s)
+}
+
+// ChannelBuilds returns the builds listed by channel.
+func (d *ReleaseDetail) ChannelBuilds(channel ReleaseChannel) []ReleaseBuild {
+	var builds []ReleaseBuild
+	for _, b := range d.Builds {
+		if b.Channel == channel {
+			builds = append(builds, b)
+		}
+	}
+	return builds
+}
+
+// GKEReleaseDetail returns the details of the GKE release version, padded or
+// not.
+func GKEReleaseDetail(version string) (*ReleaseDetail, error) {
+	return findDetail(&GKE, GKEReleaseDetails, version)
+}
+
+// findDetail returns the detail of release version, padded or not, in the
+// details of project.
+func findDetail(project *model.Project, details []ReleaseDetail, version string) (*ReleaseDetail, error) {
+	parser, err := calver.ForProject(project)
+	if err != nil {
+		return nil, err
+	}
+	want, err := parser.Parse(version)
+	if err != nil {
+		return nil, err
+	}
+	for i := range details {
+		if v, err := parser.Parse(details[i].Version); err == nil && v == want {
+			return &details[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no details recorded for %s release %s", project.ID, version)
+}
+
+// GKEReleaseSource returns the provenance of the GKE release version.
+func GKEReleaseSource(version string) (*Provenance, error) {
+	d, err := GKEReleaseDetail(version)
+	if err != nil {
+		return nil, err
+	}
+	if d.Source == nil {
+		return nil, fmt.Errorf("no source recorded for GKE release %s", version)
+	}
+	return d.Source, nil
+}
+
+// GKEOffersBuild reports whether the GKE release offers the full build in
+// channel, e.g. whether 2025-R30 lists 1.31.9-gke.1044000 on Stable other
+// than as no longer available. Builds are compared with kubever.Build
+// ordering, so "+cos" metadata must match. Like GKEReleaseChanges, it fails
+// for releases recorded without builds for channel.
+func GKEOffersBuild(release string, channel ReleaseChannel, build string) (bool, error) {
+	if channel == "" {
+		channel = DefaultChannel
+	}
+	want, err := kubever.ParseBuild(build)
+	if err != nil {
+		return false, err
+	}
+	d, err := GKEReleaseDetail(release)
+	if err != nil {
+		return false, err
+	}
+	builds := d.ChannelBuilds(channel)
+	if len(builds) == 0 {
+		return false, fmt.Errorf("no %s builds recorded for GKE release %s", channel, release)
+	}
+	offered := false
+	for _, b := range builds {
+		got, err := kubever.ParseBuild(b.Version)
+		if err != nil || got.Compare(want) != 0 {
+			continue
+		}
+		if b.Role == RoleRemoved {
+			return false, nil
+		}
+		offered = true
+	}
+	return offered, nil
+}
+
+// ReleaseChanges is what one channel of a GKE R release changed, as
+// kube@x.y.z references sorted ascending.
+type ReleaseChanges struct {
+	Release string
+	Channel ReleaseChannel
+	// Default is the newest default version, or "" if none was listed.
+	Default string
+	// Defaults holds every default listed, e.g. control plane and node
+	// defaults of several minors.
+	Defaults []string
+	Added    []string
+	Removed  []string
+}
+
+// GKEReleaseChanges returns the default version and the added and removed
+// versions of channel in the GKE release. It fails for releases recorded
+// without build details.
+func GKEReleaseChanges(release string, channel ReleaseChannel) (*ReleaseChanges, error) {
+	if channel == "" {
+		channel = DefaultChannel
+	}
+	d, err := GKEReleaseDetail(release)
+	if err != nil {
+		return nil, err
+	}
+	builds := d.ChannelBuilds(channel)
+	if len(builds) == 0 {
+		return nil, fmt.Errorf("no %s builds recorded for GKE release %s", channel, release)
+	}
+	var defaults, added, removed []kubever.Version
+	for _, b := range builds {
+		build, err := kubever.ParseBuild(b.Version)
+		if err != nil {
+			return nil, err
+		}
+		switch b.Role {
+		case RoleDefault:
+			defaults = append(defaults, build.Kube)
+		case RoleAvailable:
+			added = append(added, build.Kube)
+		case RoleRemoved:
+			removed = append(removed, build.Kube)
+		}
+	}
+	c := &ReleaseChanges{
+		Release:  d.Version,
+		Channel:  channel,
+		Defaults: kubever.SortedRefs(defaults),
+		Added:    kubever.SortedRefs(added),
+		Removed:  kubever.SortedRefs(removed),
+	}
+	if n := len(c.Defaults); n > 0 {
+		c.Default = c.Defaults[n-1]
+	}
+	return c, nil
+}
+
+// ValidateReleaseDetails checks that details are sorted newest first
+// without duplicates, that sources link to the release's own section, that
+// dates parse, that no release was published before an older one or
+// completed its rollout before starting it, that every build parses and
+// that its kube@x.y.z reference is recorded by the same release of its
+// channel.
+func ValidateReleaseDetails(project *model.Project, details []ReleaseDetail, channels map[ReleaseChannel][]model.ProjectRelease) error {
+	parser, err := calver.ForProject(project)
+	if err != nil {
+		return err
+	}
+	refs := map[ReleaseChannel]map[calver.Version]map[string]bool{}
+	for channel, releases := range channels {
+		refs[channel] = map[calver.Version]map[string]bool{}
+		for _, r := range releases {
+			v, err := parser.Parse(r.Version)
+			if err != nil {
+				continue
+			}
+			refs[channel][v] = map[string]bool{}
+			for _, ref := range r.RelatedProjectReleases {
+				refs[channel][v][ref] = true
+			}
+		}
+	}
+
+	var errs []error
+	var prev calver.Version
+	var newer *ReleaseDetail
+	var newerPublished time.Time
+	for i := range details {
+		d := &details[i]
+		v, err := parser.Parse(d.Version)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !prev.IsZero() && !v.Less(prev) {
+			errs = append(errs, fmt.Errorf("%s: not sorted newest first or duplicated, follows %s", d.Version, prev))
+		}
+		prev = v
+		if err := validateSource(d.Source, v, parser); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", d.Version, err))
+		}
+		if published, err := d.PublishedOn(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: published: %w", d.Version, err))
+		} else if !published.IsZero() {
+			if newer != nil && newerPublished.Before(published) {
+				errs = append(errs, fmt.Errorf("%s: published %s, after the newer %s on %s", d.Version, d.Published, newer.Version, newer.Published))
+			}
+			newer, newerPublished = d, published
+		}
+		if start, complete, err := d.Rollout(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: rollout: %w", d.Version, err))
+		} else if !start.IsZero() && !complete.IsZero() && complete.Before(start) {
+			errs = append(errs, fmt.Errorf("%s: rollout completes on %s, before it starts on %s", d.Version, d.RolloutComplete, d.RolloutStart))
+		}
+		for _, b := range d.Builds {
+			switch b.Role {
+			case RoleUnlabelled, RoleDefault, RoleAvailable, RoleRemoved:
+			default:
+				errs = append(errs, fmt.Errorf("%s: build %s has unknown role %q", d.Version, b.Version, b.Role))
+			}
+			ref, err := b.Kube()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", d.Version, err))
+				continue
+			}
+			recorded, ok := refs[b.Channel][v]
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: %s build %s has no %s release", d.Version, b.Channel, b.Version, b.Channel))
+			} else if !recorded[ref] {
+				errs = append(errs, fmt.Errorf("%s: %s build %s is not recorded as %s", d.Version, b.Channel, b.Version, ref))
+			}
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid %s release details: %w", project.ID, err)
+	}
+	return nil
+}
+
+// validateSource checks that source names the section of the release v:
+// its anchor must be "<YYYY>-r<MINOR>_version_updates" for v, not a date.
+func validateSource(source *Provenance, v calver.Version, parser *calver.Parser) error {
+	if source == nil {
+		return nil
+	}
+	if source.URL == "" {
+		return fmt.Errorf("source has no URL")
+	}
+	m := anchorPattern.FindStringSubmatch(source.Anchor)
+	if m == nil {
+		return fmt.Errorf("source anchor %q does not name a version updates section", source.Anchor)
+	}
+	if av, err := parser.Parse(strings.ToUpper(m[1])); err != nil || av != v {
+		return fmt.Errorf("source anchor %q names another release", source.Anchor)
+	}
+	if _, err := source.Fetched(); err != nil {
+		return fmt.Errorf("source fetch time: %w", err)
+	}
+	if source.ContentHash != "" && !hashPattern.MatchString(source.ContentHash) {
+		return fmt.Errorf("source content hash %q is not sha256:<hex>", source.ContentHash)
+	}
+	return nil
+}
+
+var (
+	anchorPattern = regexp.MustCompile(`^(\d{4}-r\d+)_version_updates$`)
+	hashPattern   = regexp.MustCompile(`^sha256:[0-9a-f]{64}$`)
+)
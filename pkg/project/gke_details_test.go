@@ -0,0 +1,23 @@
+package project
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGKEReleaseSources(t *testing.T) {
+	url := GKECurationConfig.Series.Sources[0].LinkTemplate.URLTemplate
+	for _, r := range GKEProjectReleases {
+		src, err := DefaultRegistry.ReleaseSource(GKE.ID, r.Version)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		// Anchors follow the release as its heading writes it, e.g.
+		// "2022-r9_version_updates".
+		anchor := strings.ToLower(r.Version) + "_version_updates"
+		if src.URL != url || src.Anchor != anchor {
+			t.Errorf("%s links %s, want %s#%s", r.Version, src.Link(), url, anchor)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+)
+
+// GapOutcome is what checking an R number missing from a channel's
+// releases against the release notes found.
+type GapOutcome string
+
+const (
+	// GapAbsent means the release notes have no section for the release.
+	GapAbsent GapOutcome = "absent"
+	// GapNoChannel means the section has no tab for the channel.
+	GapNoChannel GapOutcome = "no-channel"
+	// GapBackfill means the section lists versions for the channel, so the
+	// release was missed by curation.
+	GapBackfill GapOutcome = "needs-backfill"
+)
+
+// ReleaseGap records why an R number is missing from the releases of one or
+// more channels.
+type ReleaseGap struct {
+	Version string
+	// Source is the section that was checked, or the release notes page
+	// without an Anchor when the release is absent.
+	Source   *Provenance
+	Outcomes map[ReleaseChannel]GapOutcome
+}
+
+// GKEReleaseGaps holds the outcome of checking the R numbers missing from
+// the GKE releases, newest first. It is maintained with gke-sync -gaps.
+var GKEReleaseGaps = []ReleaseGap{}
+
+// GKEReleaseGap returns the recorded gap of the GKE release version, padded
+// or not.
+func GKEReleaseGap(version string) (*ReleaseGap, error) {
+	want, err := gkeParser.Parse(version)
+	if err != nil {
+		return nil, err
+	}
+	for i := range GKEReleaseGaps {
+		if v, err := gkeParser.Parse(GKEReleaseGaps[i].Version); err == nil && v == want {
+			return &GKEReleaseGaps[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no gap recorded for GKE release %s", version)
+}
+
+// ValidateReleaseGaps checks that gaps are sorted newest first without
+// duplicates, that outcomes are known and that sources with an anchor link
+// to the release's own section.
+func ValidateReleaseGaps(project *model.Project, gaps []ReleaseGap) error {
+	parser, err := calver.ForProject(project)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	var prev calver.Version
+	for _, g := range gaps {
+		v, err := parser.Parse(g.Version)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !prev.IsZero() && !v.Less(prev) {
+			errs = append(errs, fmt.Errorf("%s: not sorted newest first or duplicated, follows %s", g.Version, prev))
+		}
+		prev = v
+		if g.Source != nil && g.Source.Anchor != "" {
+			if err := validateSource(g.Source, v, parser); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", g.Version, err))
+			}
+		}
+		if len(g.Outcomes) == 0 {
+			errs = append(errs, fmt.Errorf("%s: no outcome recorded", g.Version))
+		}
+		for channel, outcome := range g.Outcomes {
+			switch outcome {
+			case GapAbsent, GapNoChannel, GapBackfill:
+			default:
+				errs = append(errs, fmt.Errorf("%s: %s has unknown outcome %q", g.Version, channel, outcome))
+			}
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid %s release gaps: %w", project.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+)
+
+// GKEReleaseScheduleURL is the page GKE publishes the support dates of each
+// Kubernetes minor on.
+const GKEReleaseScheduleURL = "https://cloud.google.com/kubernetes-engine/docs/release-schedule"
+
+// MinorSupport is the published end of support of a Kubernetes minor on a
+// provider. Dates are in DateLayout and empty when not yet published.
+type MinorSupport struct {
+	// Minor is the Kubernetes minor, e.g. "1.30".
+	Minor                string
+	EndOfStandardSupport string
+	EndOfExtendedSupport string
+	Source               *Provenance
+}
+
+// StandardEnd returns EndOfStandardSupport as a time, or the zero time when
+// unknown.
+func (s *MinorSupport) StandardEnd() (time.Time, error) {
+	return parseDate(s.EndOfStandardSupport)
+}
+
+// ExtendedEnd returns EndOfExtendedSupport as a time, or the zero time when
+// unknown.
+func (s *MinorSupport) ExtendedEnd() (time.Time, error) {
+	return parseDate(s.EndOfExtendedSupport)
+}
+
+// GKEMinorSupport holds the end of support dates GKE publishes on
+// GKEReleaseScheduleURL, newest minor first. "gke-sync -schedule" records
+// them from the page; estimated dates are left empty until published.
+var GKEMinorSupport = []MinorSupport{}
+
+// GKEMinorSupportOf returns the support dates of minor, e.g. "1.30".
+func GKEMinorSupportOf(minor string) (*MinorSupport, error) {
+	want, err := kubever.ParseMinor(minor)
+	if err != nil {
+		return nil, err
+	}
+	for i := range GKEMinorSupport {
+		if m, err := kubever.ParseMinor(GKEMinorSupport[i].Minor); err == nil && m == want {
+			return &GKEMinorSupport[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no support dates recorded for GKE %s", want)
+}
+
+// ValidateMinorSupport checks that support is sorted newest minor first
+// without duplicates, that dates parse, that extended support does not end
+// before standard support and that sources have a URL.
+func ValidateMinorSupport(project *model.Project, support []MinorSupport) error {
+	var errs []error
+	var prev kubever.Minor
+	for i, s := range support {
+		m, err := kubever.ParseMinor(s.Minor)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if i > 0 && !m.Less(prev) {
+			errs = append(errs, fmt.Errorf("%s: not sorted newest first or duplicated, follows %s", s.Minor, prev))
+		}
+		prev = m
+		standard, err := s.StandardEnd()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: end of standard support: %w", s.Minor, err))
+		}
+		extended, err := s.ExtendedEnd()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: end of extended support: %w", s.Minor, err))
+		}
+		if !standard.IsZero() && !extended.IsZero() && extended.Before(standard) {
+			errs = append(errs, fmt.Errorf("%s: extended support ends on %s, before standard support on %s", s.Minor, s.EndOfExtendedSupport, s.EndOfStandardSupport))
+		}
+		if s.Source != nil && s.Source.URL == "" {
+			errs = append(errs, fmt.Errorf("%s: source has no URL", s.Minor))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid %s minor support: %w", project.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,889 @@
+package project
+
+// GKEReleaseDetails holds the provenance and, from gke-sync onward, the full
+// build versions of every GKE R release, newest first. Releases curated
+// without a source, such as 2025-R30 to R37, link the section the
+// LinkTemplate URL and the "(YYYY-RXX) Version updates" heading give them;
+// they have no FetchedAt or ContentHash until gke-sync reads them. The
+// dates of releases whose source linked a date anchor, such as
+// #March_20_2024, are recorded as Published.
+var GKEReleaseDetails = []ReleaseDetail{
+	{
+		Version: "2025-R37",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r37_version_updates",
+		},
+	},
+	{
+		Version: "2025-R36",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r36_version_updates",
+		},
+	},
+	{
+		Version: "2025-R35",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r35_version_updates",
+		},
+	},
+	{
+		Version: "2025-R34",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r34_version_updates",
+		},
+	},
+	{
+		Version: "2025-R33",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r33_version_updates",
+		},
+	},
+	{
+		Version: "2025-R32",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r32_version_updates",
+		},
+	},
+	{
+		Version: "2025-R31",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r31_version_updates",
+		},
+	},
+	{
+		Version: "2025-R30",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r30_version_updates",
+		},
+	},
+	{
+		Version: "2025-R29",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r29_version_updates",
+		},
+	},
+	{
+		Version: "2025-R27",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r27_version_updates",
+		},
+	},
+	{
+		Version: "2025-R26",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r26_version_updates",
+		},
+	},
+	{
+		Version: "2025-R25",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r25_version_updates",
+		},
+	},
+	{
+		Version: "2025-R24",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r24_version_updates",
+		},
+	},
+	{
+		Version: "2025-R22",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r22_version_updates",
+		},
+	},
+	{
+		Version: "2025-R20",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r20_version_updates",
+		},
+	},
+	{
+		Version: "2025-R19",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r19_version_updates",
+		},
+	},
+	{
+		Version: "2025-R18",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r18_version_updates",
+		},
+	},
+	{
+		Version: "2025-R17",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r17_version_updates",
+		},
+	},
+	{
+		Version: "2025-R16",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r16_version_updates",
+		},
+	},
+	{
+		Version: "2025-R15",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r15_version_updates",
+		},
+	},
+	{
+		Version: "2025-R14",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r14_version_updates",
+		},
+	},
+	{
+		Version: "2025-R13",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r13_version_updates",
+		},
+	},
+	{
+		Version: "2025-R12",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r12_version_updates",
+		},
+	},
+	{
+		Version: "2025-R11",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r11_version_updates",
+		},
+	},
+	{
+		Version: "2025-R10",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r10_version_updates",
+		},
+	},
+	{
+		Version: "2025-R09",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r09_version_updates",
+		},
+	},
+	{
+		Version: "2025-R08",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r08_version_updates",
+		},
+	},
+	{
+		Version: "2025-R07",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r07_version_updates",
+		},
+	},
+	{
+		Version: "2025-R06",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r06_version_updates",
+		},
+	},
+	{
+		Version: "2025-R05",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r05_version_updates",
+		},
+	},
+	{
+		Version: "2025-R04",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r04_version_updates",
+		},
+	},
+	{
+		Version: "2025-R03",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r03_version_updates",
+		},
+	},
+	{
+		Version: "2025-R02",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r02_version_updates",
+		},
+	},
+	{
+		Version: "2025-R01",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2025-r01_version_updates",
+		},
+	},
+	{
+		Version: "2024-R50",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r50_version_updates",
+		},
+	},
+	{
+		Version: "2024-R49",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r49_version_updates",
+		},
+	},
+	{
+		Version: "2024-R48",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r48_version_updates",
+		},
+	},
+	{
+		Version: "2024-R47",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r47_version_updates",
+		},
+	},
+	{
+		Version: "2024-R46",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r46_version_updates",
+		},
+	},
+	{
+		Version: "2024-R45",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r45_version_updates",
+		},
+	},
+	{
+		Version: "2024-R44",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r44_version_updates",
+		},
+	},
+	{
+		Version: "2024-R43",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r43_version_updates",
+		},
+	},
+	{
+		Version: "2024-R42",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r42_version_updates",
+		},
+	},
+	{
+		Version: "2024-R41",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r41_version_updates",
+		},
+	},
+	{
+		Version: "2024-R40",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r40_version_updates",
+		},
+	},
+	{
+		Version: "2024-R39",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r39_version_updates",
+		},
+	},
+	{
+		Version: "2024-R38",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r38_version_updates",
+		},
+	},
+	{
+		Version: "2024-R36",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r36_version_updates",
+		},
+	},
+	{
+		Version: "2024-R35",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r35_version_updates",
+		},
+	},
+	{
+		Version: "2024-R32",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r32_version_updates",
+		},
+	},
+	{
+		Version: "2024-R27",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r27_version_updates",
+		},
+	},
+	{
+		Version: "2024-R25",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r25_version_updates",
+		},
+	},
+	{
+		Version: "2024-R23",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r23_version_updates",
+		},
+	},
+	{
+		Version: "2024-R21",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r21_version_updates",
+		},
+	},
+	{
+		Version: "2024-R20",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r20_version_updates",
+		},
+	},
+	{
+		Version: "2024-R19",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r19_version_updates",
+		},
+	},
+	{
+		Version: "2024-R18",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r18_version_updates",
+		},
+	},
+	{
+		Version: "2024-R16",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r16_version_updates",
+		},
+	},
+	{
+		Version: "2024-R14",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r14_version_updates",
+		},
+	},
+	{
+		Version: "2024-R13",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r13_version_updates",
+		},
+	},
+	{
+		Version: "2024-R12",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r12_version_updates",
+		},
+	},
+	{
+		Version: "2024-R11",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r11_version_updates",
+		},
+	},
+	{
+		Version: "2024-R10",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r10_version_updates",
+		},
+	},
+	{
+		Version: "2024-R09",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r09_version_updates",
+		},
+	},
+	{
+		Version: "2024-R08",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r08_version_updates",
+		},
+		Published: "2024-03-20",
+	},
+	{
+		Version: "2024-R07",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r07_version_updates",
+		},
+		Published: "2024-03-07",
+	},
+	{
+		Version: "2024-R06",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r06_version_updates",
+		},
+		Published: "2024-03-04",
+	},
+	{
+		Version: "2024-R05",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r05_version_updates",
+		},
+	},
+	{
+		Version: "2024-R04",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r04_version_updates",
+		},
+	},
+	{
+		Version: "2024-R03",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r03_version_updates",
+		},
+	},
+	{
+		Version: "2024-R02",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r02_version_updates",
+		},
+	},
+	{
+		Version: "2024-R01",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2024-r01_version_updates",
+		},
+	},
+	{
+		Version: "2023-R26",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r26_version_updates",
+		},
+	},
+	{
+		Version: "2023-R25",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r25_version_updates",
+		},
+	},
+	{
+		Version: "2023-R24",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r24_version_updates",
+		},
+	},
+	{
+		Version: "2023-R23",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r23_version_updates",
+		},
+	},
+	{
+		Version: "2023-R22",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r22_version_updates",
+		},
+	},
+	{
+		Version: "2023-R20",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r20_version_updates",
+		},
+	},
+	{
+		Version: "2023-R19",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r19_version_updates",
+		},
+	},
+	{
+		Version: "2023-R18",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r18_version_updates",
+		},
+	},
+	{
+		Version: "2023-R17",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r17_version_updates",
+		},
+	},
+	{
+		Version: "2023-R16",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r16_version_updates",
+		},
+	},
+	{
+		Version: "2023-R15",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r15_version_updates",
+		},
+	},
+	{
+		Version: "2023-R14",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r14_version_updates",
+		},
+	},
+	{
+		Version: "2023-R13",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r13_version_updates",
+		},
+	},
+	{
+		Version: "2023-R12",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r12_version_updates",
+		},
+	},
+	{
+		Version: "2023-R11",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r11_version_updates",
+		},
+	},
+	{
+		Version: "2023-R10",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r10_version_updates",
+		},
+	},
+	{
+		Version: "2023-R09",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r09_version_updates",
+		},
+	},
+	{
+		Version: "2023-R08",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r08_version_updates",
+		},
+	},
+	{
+		Version: "2023-R07",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r07_version_updates",
+		},
+	},
+	{
+		Version: "2023-R06",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r06_version_updates",
+		},
+	},
+	{
+		Version: "2023-R05",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r05_version_updates",
+		},
+	},
+	{
+		Version: "2023-R04",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r04_version_updates",
+		},
+	},
+	{
+		Version: "2023-R03",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r03_version_updates",
+		},
+	},
+	{
+		Version: "2023-R02",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r02_version_updates",
+		},
+	},
+	{
+		Version: "2023-R01",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2023-r01_version_updates",
+		},
+	},
+	{
+		Version: "2022-R28",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r28_version_updates",
+		},
+	},
+	{
+		Version: "2022-R27",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r27_version_updates",
+		},
+	},
+	{
+		Version: "2022-R26",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r26_version_updates",
+		},
+	},
+	{
+		Version: "2022-R25",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r25_version_updates",
+		},
+	},
+	{
+		Version: "2022-R24",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r24_version_updates",
+		},
+	},
+	{
+		Version: "2022-R23",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r23_version_updates",
+		},
+	},
+	{
+		Version: "2022-R22",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r22_version_updates",
+		},
+	},
+	{
+		Version: "2022-R21",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r21_version_updates",
+		},
+	},
+	{
+		Version: "2022-R20",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r20_version_updates",
+		},
+	},
+	{
+		Version: "2022-R19",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r19_version_updates",
+		},
+	},
+	{
+		Version: "2022-R18",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r18_version_updates",
+		},
+	},
+	{
+		Version: "2022-R17",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r17_version_updates",
+		},
+	},
+	{
+		Version: "2022-R16",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r16_version_updates",
+		},
+	},
+	{
+		Version: "2022-R15",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r15_version_updates",
+		},
+	},
+	{
+		Version: "2022-R14",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r14_version_updates",
+		},
+	},
+	{
+		Version: "2022-R13",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r13_version_updates",
+		},
+	},
+	{
+		Version: "2022-R12",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r12_version_updates",
+		},
+	},
+	{
+		Version: "2022-R11",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r11_version_updates",
+		},
+	},
+	{
+		Version: "2022-R10",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r10_version_updates",
+		},
+	},
+	{
+		Version: "2022-R9",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r9_version_updates",
+		},
+	},
+	{
+		Version: "2022-R8",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r8_version_updates",
+		},
+	},
+	{
+		Version: "2022-R7",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r7_version_updates",
+		},
+	},
+	{
+		Version: "2022-R6",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r6_version_updates",
+		},
+	},
+	{
+		Version: "2022-R5",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r5_version_updates",
+		},
+	},
+	{
+		Version: "2022-R4",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r4_version_updates",
+		},
+	},
+	{
+		Version: "2022-R3",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r3_version_updates",
+		},
+	},
+	{
+		Version: "2022-R02",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r02_version_updates",
+		},
+	},
+	{
+		Version: "2022-R01",
+		Source: &Provenance{
+			URL:    "https://cloud.google.com/kubernetes-engine/docs/release-notes",
+			Anchor: "2022-r01_version_updates",
+		},
+	},
+}
@@ -0,0 +1,345 @@
+package project
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/chkk-io/schema/model"
+)
+
+// Registry stores projects, their releases and curation configs by project
+// ID. It is safe for concurrent use.
+type Registry struct {
+	mu       sync.RWMutex
+	projects map[string]*model.Project
+	aliases  map[string]string
+	releases map[string]map[ReleaseChannel][]model.ProjectRelease
+	details  map[string][]ReleaseDetail
+	gaps     map[string][]ReleaseGap
+	support  map[string][]MinorSupport
+	curation map[string]*model.ProjectCurationConfig
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		projects: map[string]*model.Project{},
+		aliases:  map[string]string{},
+		releases: map[string]map[ReleaseChannel][]model.ProjectRelease{},
+		details:  map[string][]ReleaseDetail{},
+		gaps:     map[string][]ReleaseGap{},
+		support:  map[string][]MinorSupport{},
+		curation: map[string]*model.ProjectCurationConfig{},
+	}
+}
+
+// DefaultRegistry holds the projects registered by this package's init
+// functions.
+var DefaultRegistry = NewRegistry()
+
+// RegisterProject adds p. Its ID and Aliases must not name another
+// registered project.
+func (r *Registry) RegisterProject(p *model.Project) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if p.ID == "" {
+		return fmt.Errorf("project has no ID")
+	}
+	if _, ok := r.projects[p.ID]; ok {
+		return fmt.Errorf("project %s already registered", p.ID)
+	}
+	if owner, ok := r.aliases[p.ID]; ok {
+		return fmt.Errorf("project ID %s is already an alias of %s", p.ID, owner)
+	}
+	for _, alias := range p.Aliases {
+		if owner, ok := r.aliases[alias]; ok && owner != p.ID {
+			return fmt.Errorf("alias %s of %s is already an alias of %s", alias, p.ID, owner)
+		}
+		if _, ok := r.projects[alias]; ok && alias != p.ID {
+			return fmt.Errorf("alias %s of %s is already a project ID", alias, p.ID)
+		}
+	}
+	r.projects[p.ID] = p
+	for _, alias := range p.Aliases {
+		r.aliases[alias] = p.ID
+	}
+	return nil
+}
+
+// RegisterProjectReleases adds the releases of project id, which must be
+// registered, after checking them with ValidateProjectReleases. For
+// projects with release channels these are the DefaultChannel releases.
+func (r *Registry) RegisterProjectReleases(id string, releases []model.ProjectRelease) error {
+	return r.RegisterChannelReleases(id, DefaultChannel, releases)
+}
+
+// RegisterChannelReleases adds the releases of one release channel of
+// project id after checking them with ValidateProjectReleases.
+func (r *Registry) RegisterChannelReleases(id string, channel ReleaseChannel, releases []model.ProjectRelease) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.projects[id]
+	if !ok {
+		return fmt.Errorf("project %s not registered", id)
+	}
+	if _, ok := r.releases[id][channel]; ok {
+		return fmt.Errorf("%s releases of project %s already registered", channel, id)
+	}
+	if err := ValidateProjectReleases(p, releases); err != nil {
+		return err
+	}
+	if r.releases[id] == nil {
+		r.releases[id] = map[ReleaseChannel][]model.ProjectRelease{}
+	}
+	r.releases[id][channel] = releases
+	return nil
+}
+
+// RegisterReleaseDetails adds the release details of project id after
+// checking them with ValidateReleaseDetails against its registered channel
+// releases.
+func (r *Registry) RegisterReleaseDetails(id string, details []ReleaseDetail) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.projects[id]
+	if !ok {
+		return fmt.Errorf("project %s not registered", id)
+	}
+	if _, ok := r.details[id]; ok {
+		return fmt.Errorf("release details of project %s already registered", id)
+	}
+	if err := ValidateReleaseDetails(p, details, r.releases[id]); err != nil {
+		return err
+	}
+	r.details[id] = details
+	return nil
+}
+
+// RegisterReleaseGaps adds the release gaps of project id after checking
+// them with ValidateReleaseGaps.
+func (r *Registry) RegisterReleaseGaps(id string, gaps []ReleaseGap) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.projects[id]
+	if !ok {
+		return fmt.Errorf("project %s not registered", id)
+	}
+	if _, ok := r.gaps[id]; ok {
+		return fmt.Errorf("release gaps of project %s already registered", id)
+	}
+	if err := ValidateReleaseGaps(p, gaps); err != nil {
+		return err
+	}
+	r.gaps[id] = gaps
+	return nil
+}
+
+// RegisterMinorSupport adds the minor support dates of project id after
+// checking them with ValidateMinorSupport.
+func (r *Registry) RegisterMinorSupport(id string, support []MinorSupport) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.projects[id]
+	if !ok {
+		return fmt.Errorf("project %s not registered", id)
+	}
+	if _, ok := r.support[id]; ok {
+		return fmt.Errorf("minor support of project %s already registered", id)
+	}
+	if err := ValidateMinorSupport(p, support); err != nil {
+		return err
+	}
+	r.support[id] = support
+	return nil
+}
+
+// RegisterCurationConfig adds the curation config of project id.
+func (r *Registry) RegisterCurationConfig(id string, cfg *model.ProjectCurationConfig) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.projects[id]; !ok {
+		return fmt.Errorf("project %s not registered", id)
+	}
+	if _, ok := r.curation[id]; ok {
+		return fmt.Errorf("curation config of project %s already registered", id)
+	}
+	r.curation[id] = cfg
+	return nil
+}
+
+// resolve returns the ID of the project named by an ID or alias.
+func (r *Registry) resolve(name string) (string, bool) {
+	if _, ok := r.projects[name]; ok {
+		return name, true
+	}
+	id, ok := r.aliases[name]
+	return id, ok
+}
+
+// Project returns the project with the ID or alias name, e.g. "gke" or
+// "cloud.google.com/gke".
+func (r *Registry) Project(name string) (*model.Project, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	id, ok := r.resolve(name)
+	if !ok {
+		return nil, false
+	}
+	return r.projects[id], true
+}
+
+// Projects returns every registered project sorted by ID.
+func (r *Registry) Projects() []*model.Project {
+	return r.ProjectsByType("")
+}
+
+// ProjectsByType returns the projects of type t sorted by ID, or every
+// project when t is empty.
+func (r *Registry) ProjectsByType(t model.ProjectType) []*model.Project {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var out []*model.Project
+	for _, p := range r.projects {
+		if t == "" || p.Type == t {
+			out = append(out, p)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+// ProjectReleases returns the DefaultChannel releases of the project named
+// by an ID or alias.
+func (r *Registry) ProjectReleases(name string) ([]model.ProjectRelease, bool) {
+	return r.ChannelReleases(name, DefaultChannel)
+}
+
+// ChannelReleases returns the releases of one release channel of the
+// project named by an ID or alias.
+func (r *Registry) ChannelReleases(name string, channel ReleaseChannel) ([]model.ProjectRelease, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	id, ok := r.resolve(name)
+	if !ok {
+		return nil, false
+	}
+	releases, ok := r.releases[id][channel]
+	return releases, ok
+}
+
+// ReleaseDetails returns the release details of the project named by an ID
+// or alias.
+func (r *Registry) ReleaseDetails(name string) ([]ReleaseDetail, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	id, ok := r.resolve(name)
+	if !ok {
+		return nil, false
+	}
+	details, ok := r.details[id]
+	return details, ok
+}
+
+// ReleaseSource returns the provenance of release version, padded or not,
+// of the project named by an ID or alias.
+func (r *Registry) ReleaseSource(name, version string) (*Provenance, error) {
+	p, ok := r.Project(name)
+	if !ok {
+		return nil, fmt.Errorf("project %s not registered", name)
+	}
+	details, _ := r.ReleaseDetails(name)
+	d, err := findDetail(p, details, version)
+	if err != nil {
+		return nil, err
+	}
+	if d.Source == nil {
+		return nil, fmt.Errorf("no source recorded for %s release %s", p.ID, version)
+	}
+	return d.Source, nil
+}
+
+// ReleaseGaps returns the release gaps of the project named by an ID or
+// alias.
+func (r *Registry) ReleaseGaps(name string) ([]ReleaseGap, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	id, ok := r.resolve(name)
+	if !ok {
+		return nil, false
+	}
+	gaps, ok := r.gaps[id]
+	return gaps, ok
+}
+
+// MinorSupport returns the minor support dates of the project named by an
+// ID or alias.
+func (r *Registry) MinorSupport(name string) ([]MinorSupport, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	id, ok := r.resolve(name)
+	if !ok {
+		return nil, false
+	}
+	support, ok := r.support[id]
+	return support, ok
+}
+
+// CurationConfig returns the curation config of the project named by an ID
+// or alias.
+func (r *Registry) CurationConfig(name string) (*model.ProjectCurationConfig, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	id, ok := r.resolve(name)
+	if !ok {
+		return nil, false
+	}
+	cfg, ok := r.curation[id]
+	return cfg, ok
+}
+
+// RegisterProject adds p to DefaultRegistry and panics on error.
+func RegisterProject(p *model.Project) {
+	must(DefaultRegistry.RegisterProject(p))
+}
+
+// RegisterProjectReleases adds releases to DefaultRegistry and panics on
+// error, so invalid data fails at init.
+func RegisterProjectReleases(id string, releases []model.ProjectRelease) {
+	must(DefaultRegistry.RegisterProjectReleases(id, releases))
+}
+
+// RegisterChannelReleases adds channel releases to DefaultRegistry and
+// panics on error.
+func RegisterChannelReleases(id string, channel ReleaseChannel, releases []model.ProjectRelease) {
+	must(DefaultRegistry.RegisterChannelReleases(id, channel, releases))
+}
+
+// RegisterReleaseDetails adds release details to DefaultRegistry and panics
+// on error.
+func RegisterReleaseDetails(id string, details []ReleaseDetail) {
+	must(DefaultRegistry.RegisterReleaseDetails(id, details))
+}
+
+// RegisterReleaseGaps adds release gaps to DefaultRegistry and panics on
+// error.
+func RegisterReleaseGaps(id string, gaps []ReleaseGap) {
+	must(DefaultRegistry.RegisterReleaseGaps(id, gaps))
+}
+
+// RegisterMinorSupport adds minor support dates to DefaultRegistry and
+// panics on error.
+func RegisterMinorSupport(id string, support []MinorSupport) {
+	must(DefaultRegistry.RegisterMinorSupport(id, support))
+}
+
+// RegisterCurationConfig adds cfg to DefaultRegistry and panics on error.
+func RegisterCurationConfig(id string, cfg *model.ProjectCurationConfig) {
+	must(DefaultRegistry.RegisterCurationConfig(id, cfg))
+}
+
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,145 @@
+package project
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/chkk-io/schema/model"
+)
+
+// testProject returns a project with GKE's versioning, ID id and aliases.
+func testProject(id string, aliases ...string) *model.Project {
+	return &model.Project{
+		ID:         id,
+		Type:       model.ProjectTypeKubeControlPlaneProvider,
+		Aliases:    aliases,
+		Versioning: GKE.Versioning,
+	}
+}
+
+func TestDefaultRegistryAliases(t *testing.T) {
+	for _, name := range append([]string{GKE.ID}, GKE.Aliases...) {
+		p, ok := DefaultRegistry.Project(name)
+		if !ok || p.ID != GKE.ID {
+			t.Errorf("Project(%q) = %v, %v; want %s", name, p, ok, GKE.ID)
+		}
+		if releases, ok := DefaultRegistry.ProjectReleases(name); !ok || len(releases) != len(GKEProjectReleases) {
+			t.Errorf("ProjectReleases(%q) = %d releases, %v; want %d", name, len(releases), ok, len(GKEProjectReleases))
+		}
+	}
+	found := false
+	for _, p := range DefaultRegistry.ProjectsByType(model.ProjectTypeKubeControlPlaneProvider) {
+		found = found || p.ID == GKE.ID
+	}
+	if !found {
+		t.Errorf("ProjectsByType(%s) does not list %s", model.ProjectTypeKubeControlPlaneProvider, GKE.ID)
+	}
+}
+
+func TestRegistryRejectsDuplicates(t *testing.T) {
+	r := NewRegistry()
+	if err := r.RegisterProject(testProject("p1", "one")); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []*model.Project{
+		testProject("p1"),
+		testProject("p2", "one"),
+		testProject("one"),
+		testProject("p3", "p1"),
+		testProject(""),
+	} {
+		if err := r.RegisterProject(p); err == nil {
+			t.Errorf("RegisterProject(%s, aliases %v) succeeded", p.ID, p.Aliases)
+		}
+	}
+	if err := r.RegisterProjectReleases("p1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.RegisterProjectReleases("p1", nil); err == nil {
+		t.Error("second RegisterProjectReleases(p1) succeeded")
+	}
+	if err := r.RegisterProjectReleases("missing", nil); err == nil {
+		t.Error("RegisterProjectReleases of an unregistered project succeeded")
+	}
+}
+
+func TestRegistryConcurrentRegistration(t *testing.T) {
+	const n = 32
+	r := NewRegistry()
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id := fmt.Sprintf("p%d", i)
+			if err := r.RegisterProject(testProject(id, id+"-alias")); err != nil {
+				t.Error(err)
+				return
+			}
+			if err := r.RegisterProjectReleases(id, []model.ProjectRelease{{Project: id, Version: "2025-R01"}}); err != nil {
+				t.Error(err)
+			}
+			if err := r.RegisterCurationConfig(id, &model.ProjectCurationConfig{}); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	// Lookups race with registration; each either misses or sees a whole
+	// project.
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("p%d-alias", i)
+			if p, ok := r.Project(name); ok && p.ID != fmt.Sprintf("p%d", i) {
+				t.Errorf("Project(%q) = %s", name, p.ID)
+			}
+			r.ChannelReleases(name, DefaultChannel)
+			r.ProjectsByType(model.ProjectTypeKubeControlPlaneProvider)
+		}(i)
+	}
+	wg.Wait()
+
+	if got := len(r.Projects()); got != n {
+		t.Fatalf("Projects() = %d projects, want %d", got, n)
+	}
+	for i := 0; i < n; i++ {
+		alias := fmt.Sprintf("p%d-alias", i)
+		p, ok := r.Project(alias)
+		if !ok || p.ID != fmt.Sprintf("p%d", i) {
+			t.Errorf("Project(%q) = %v, %v", alias, p, ok)
+		}
+		if releases, ok := r.ProjectReleases(alias); !ok || len(releases) != 1 {
+			t.Errorf("ProjectReleases(%q) = %v, %v", alias, releases, ok)
+		}
+		if _, ok := r.CurationConfig(alias); !ok {
+			t.Errorf("CurationConfig(%q) missing", alias)
+		}
+	}
+}
+
+func TestRegistryConcurrentDuplicateRegistration(t *testing.T) {
+	const n = 16
+	r := NewRegistry()
+	var wg sync.WaitGroup
+	var registered atomic.Int32
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// Every goroutine claims the same alias.
+			if err := r.RegisterProject(testProject(fmt.Sprintf("p%d", i), "shared")); err == nil {
+				registered.Add(1)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if got := registered.Load(); got != 1 {
+		t.Fatalf("%d registrations of alias shared succeeded, want 1", got)
+	}
+	if _, ok := r.Project("shared"); !ok {
+		t.Error("alias shared does not resolve")
+	}
+}
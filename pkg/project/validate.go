@@ -0,0 +1,80 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chkk-io/schema/model"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+	"github.com/abdulmajid3352/codecamp/pkg/kubever"
+)
+
+// ValidateProjectReleases checks the releases of project before they are
+// registered. It reports every problem found:
+//   - releases of another project
+//   - versions that don't match the project's ReleasePatterns
+//   - duplicate versions, including padded and unpadded forms of the same one
+//   - releases not sorted newest first
+//   - malformed, unsorted or duplicated kube@x.y.z RelatedProjectReleases
+func ValidateProjectReleases(project *model.Project, releases []model.ProjectRelease) error {
+	parser, err := calver.ForProject(project)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	seen := make(map[calver.Version]string, len(releases))
+	var prev calver.Version
+	for _, r := range releases {
+		if r.Project != project.ID {
+			errs = append(errs, fmt.Errorf("%s: belongs to project %s, not %s", r.Version, r.Project, project.ID))
+		}
+		v, err := parser.Parse(r.Version)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			if first, ok := seen[v]; ok {
+				errs = append(errs, fmt.Errorf("%s: duplicate of %s", r.Version, first))
+			} else {
+				if !prev.IsZero() && !v.Less(prev) {
+					errs = append(errs, fmt.Errorf("%s: not sorted newest first, follows %s", r.Version, prev))
+				}
+				seen[v] = r.Version
+			}
+			prev = v
+		}
+		for _, err := range validateRelated(r.RelatedProjectReleases) {
+			errs = append(errs, fmt.Errorf("%s: %w", r.Version, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("invalid %s releases: %w", project.ID, err)
+	}
+	return nil
+}
+
+// validateRelated checks that refs are kube@x.y.z references sorted
+// ascending without duplicates. Malformed refs are skipped when checking
+// the order, so each ref is compared with the last well-formed one.
+func validateRelated(refs []string) []error {
+	var errs []error
+	var prev kubever.Version
+	seen := false
+	for _, ref := range refs {
+		v, err := kubever.ParseRef(ref)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if seen {
+			switch v.Compare(prev) {
+			case 0:
+				errs = append(errs, fmt.Errorf("duplicate %s", ref))
+			case -1:
+				errs = append(errs, fmt.Errorf("%s not sorted ascending, follows %s", ref, prev.Ref()))
+			}
+		}
+		prev, seen = v, true
+	}
+	return errs
+}
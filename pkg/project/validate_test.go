@@ -0,0 +1,119 @@
+package project
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chkk-io/schema/model"
+)
+
+func release(version string, refs ...string) model.ProjectRelease {
+	return model.ProjectRelease{Project: GKE.ID, Version: version, RelatedProjectReleases: refs}
+}
+
+func TestValidateProjectReleasesAccepts(t *testing.T) {
+	if err := ValidateProjectReleases(&GKE, GKEProjectReleases); err != nil {
+		t.Fatalf("GKEProjectReleases: %v", err)
+	}
+	for _, channel := range []ReleaseChannel{ChannelRapid, ChannelRegular, ChannelExtended} {
+		releases, err := GKEReleases(channel)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ValidateProjectReleases(&GKE, releases); err != nil {
+			t.Errorf("%s releases: %v", channel, err)
+		}
+	}
+}
+
+func TestValidateProjectReleasesRejects(t *testing.T) {
+	tests := []struct {
+		name     string
+		releases []model.ProjectRelease
+		want     string
+	}{
+		{
+			name:     "duplicate version",
+			releases: []model.ProjectRelease{release("2025-R02"), release("2025-R2")},
+			want:     "2025-R2: duplicate of 2025-R02",
+		},
+		{
+			name:     "pattern mismatch",
+			releases: []model.ProjectRelease{release("2025.37")},
+			want:     `version "2025.37" does not match <YYYY>-R<MINOR>`,
+		},
+		{
+			name:     "not sorted newest first",
+			releases: []model.ProjectRelease{release("2025-R09"), release("2025-R10")},
+			want:     "2025-R10: not sorted newest first, follows 2025-R09",
+		},
+		{
+			name:     "refs not sorted",
+			releases: []model.ProjectRelease{release("2025-R10", "kube@1.31.9", "kube@1.30.12")},
+			want:     "2025-R10: kube@1.30.12 not sorted ascending, follows kube@1.31.9",
+		},
+		{
+			name:     "duplicate ref",
+			releases: []model.ProjectRelease{release("2025-R10", "kube@1.31.9", "kube@1.31.9")},
+			want:     "2025-R10: duplicate kube@1.31.9",
+		},
+		{
+			name:     "ref without prefix",
+			releases: []model.ProjectRelease{release("2025-R10", "1.31.9")},
+			want:     `2025-R10: invalid kube reference "1.31.9": missing "kube@" prefix`,
+		},
+		{
+			name:     "ref with a build suffix",
+			releases: []model.ProjectRelease{release("2025-R10", "kube@1.31.9-gke.1044000")},
+			want:     `2025-R10: invalid kube reference "kube@1.31.9-gke.1044000"`,
+		},
+		{
+			name:     "release of another project",
+			releases: []model.ProjectRelease{{Project: "eks", Version: "2025-R10"}},
+			want:     "2025-R10: belongs to project eks",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateProjectReleases(&GKE, tt.releases)
+			if err == nil {
+				t.Fatalf("accepted %v", tt.releases)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateRelatedAfterMalformedRef(t *testing.T) {
+	tests := []struct {
+		refs []string
+		want []string
+	}{
+		{
+			refs: []string{"kube@1.31.x", "kube@0.0.0"},
+			want: []string{`invalid kube reference "kube@1.31.x"`},
+		},
+		{
+			refs: []string{"kube@1.31.9", "kube@1.31.x", "kube@1.32.1"},
+			want: []string{`invalid kube reference "kube@1.31.x"`},
+		},
+		{
+			refs: []string{"kube@1.32.1", "kube@1.31.x", "kube@1.31.9"},
+			want: []string{`invalid kube reference "kube@1.31.x"`, "kube@1.31.9 not sorted ascending, follows kube@1.32.1"},
+		},
+	}
+	for _, tt := range tests {
+		errs := validateRelated(tt.refs)
+		if len(errs) != len(tt.want) {
+			t.Errorf("validateRelated(%v) = %v, want %d errors", tt.refs, errs, len(tt.want))
+			continue
+		}
+		for i, err := range errs {
+			if !strings.Contains(err.Error(), tt.want[i]) {
+				t.Errorf("validateRelated(%v)[%d] = %q, want it to contain %q", tt.refs, i, err, tt.want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package rewrite
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+)
+
+// Decode stores the elements of the slice name in out, a pointer to a slice
+// such as *[]project.ReleaseDetail, so callers merge with what the file
+// records rather than with the data compiled into them.
+//
+// Elements may use string, integer and boolean literals, composite literals
+// with or without elided types, &T{...} pointers and maps. Other
+// identifiers and selectors, e.g. "ChannelStable" or "GKE.ID", are looked
+// up in idents. Fields missing from a literal keep their zero value.
+func (f *File) Decode(name string, out any, idents map[string]any) error {
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("decoding %s: need a pointer to a slice, not %T", name, out)
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", f.src, 0)
+	if err != nil {
+		return err
+	}
+	lit := findVar(file, name)
+	if lit == nil {
+		return fmt.Errorf("variable %s not found", name)
+	}
+	d := &decoder{fset: fset, idents: idents}
+	if err := d.value(lit, v.Elem()); err != nil {
+		return fmt.Errorf("decoding %s: %w", name, err)
+	}
+	return nil
+}
+
+type decoder struct {
+	fset   *token.FileSet
+	idents map[string]any
+}
+
+func (d *decoder) value(expr ast.Expr, v reflect.Value) error {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return d.value(e.X, v)
+	case *ast.UnaryExpr:
+		if e.Op == token.AND && v.Kind() == reflect.Pointer {
+			p := reflect.New(v.Type().Elem())
+			if err := d.value(e.X, p.Elem()); err != nil {
+				return err
+			}
+			v.Set(p)
+			return nil
+		}
+	case *ast.CompositeLit:
+		return d.composite(e, v)
+	case *ast.BasicLit:
+		return d.basic(e, v)
+	case *ast.Ident:
+		switch e.Name {
+		case "nil":
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		case "true", "false":
+			return d.constant(e, e.Name == "true", v)
+		}
+		if c, ok := d.idents[e.Name]; ok {
+			return d.constant(e, c, v)
+		}
+		return d.errorf(e, "unknown identifier %s", e.Name)
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok {
+			name := x.Name + "." + e.Sel.Name
+			if c, ok := d.idents[name]; ok {
+				return d.constant(e, c, v)
+			}
+			return d.errorf(e, "unknown identifier %s", name)
+		}
+	}
+	return d.errorf(expr, "unsupported expression for %s", v.Type())
+}
+
+func (d *decoder) composite(lit *ast.CompositeLit, v reflect.Value) error {
+	if v.Kind() == reflect.Pointer {
+		// An element of []*T written as {...}.
+		p := reflect.New(v.Type().Elem())
+		if err := d.composite(lit, p.Elem()); err != nil {
+			return err
+		}
+		v.Set(p)
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				return d.errorf(elt, "%s literal without field names", v.Type())
+			}
+			key, ok := kv.Key.(*ast.Ident)
+			if !ok {
+				return d.errorf(kv.Key, "invalid field name")
+			}
+			field := v.FieldByName(key.Name)
+			if !field.IsValid() || !field.CanSet() {
+				return d.errorf(key, "%s has no field %s", v.Type(), key.Name)
+			}
+			if err := d.value(kv.Value, field); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Slice:
+		s := reflect.MakeSlice(v.Type(), len(lit.Elts), len(lit.Elts))
+		for i, elt := range lit.Elts {
+			if _, ok := elt.(*ast.KeyValueExpr); ok {
+				return d.errorf(elt, "indexed slice elements are not supported")
+			}
+			if err := d.value(elt, s.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+		return nil
+	case reflect.Map:
+		m := reflect.MakeMapWithSize(v.Type(), len(lit.Elts))
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				return d.errorf(elt, "map element without a key")
+			}
+			key := reflect.New(v.Type().Key()).Elem()
+			if err := d.value(kv.Key, key); err != nil {
+				return err
+			}
+			val := reflect.New(v.Type().Elem()).Elem()
+			if err := d.value(kv.Value, val); err != nil {
+				return err
+			}
+			m.SetMapIndex(key, val)
+		}
+		v.Set(m)
+		return nil
+	}
+	return d.errorf(lit, "composite literal for %s", v.Type())
+}
+
+func (d *decoder) basic(lit *ast.BasicLit, v reflect.Value) error {
+	switch lit.Kind {
+	case token.STRING:
+		s, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return d.errorf(lit, "%v", err)
+		}
+		return d.constant(lit, s, v)
+	case token.INT:
+		n, err := strconv.ParseInt(lit.Value, 0, 64)
+		if err != nil {
+			return d.errorf(lit, "%v", err)
+		}
+		return d.constant(lit, n, v)
+	case token.FLOAT:
+		n, err := strconv.ParseFloat(lit.Value, 64)
+		if err != nil {
+			return d.errorf(lit, "%v", err)
+		}
+		return d.constant(lit, n, v)
+	}
+	return d.errorf(lit, "unsupported literal %s", lit.Value)
+}
+
+// constant stores c in v, converting between named and underlying types
+// such as string and ReleaseChannel.
+func (d *decoder) constant(at ast.Node, c any, v reflect.Value) error {
+	cv := reflect.ValueOf(c)
+	if !cv.IsValid() || !cv.Type().ConvertibleTo(v.Type()) ||
+		cv.Kind() == reflect.String && v.Kind() != reflect.String ||
+		cv.Kind() != reflect.String && v.Kind() == reflect.String {
+		return d.errorf(at, "cannot use %v as %s", c, v.Type())
+	}
+	v.Set(cv.Convert(v.Type()))
+	return nil
+}
+
+func (d *decoder) errorf(at ast.Node, format string, args ...any) error {
+	return fmt.Errorf("%s: %s", d.fset.Position(at.Pos()), fmt.Sprintf(format, args...))
+}
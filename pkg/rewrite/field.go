@@ -0,0 +1,76 @@
+package rewrite
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+)
+
+// SetField replaces the value of a field of the composite literal assigned
+// to the variable name. path names the field through nested literals, e.g.
+// "Versioning", "ReleaseIntervalDays" in GKE; literals behind & are
+// followed. An empty path replaces the whole literal, e.g. to regenerate a
+// slice. value is a Go expression.
+func (f *File) SetField(name string, path []string, value string) (Change, error) {
+	if _, err := parser.ParseExpr(value); err != nil {
+		return Unchanged, fmt.Errorf("invalid value for %s: %w", name, err)
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", f.src, parser.ParseComments)
+	if err != nil {
+		return Unchanged, err
+	}
+	lit := findVar(file, name)
+	if lit == nil {
+		return Unchanged, fmt.Errorf("variable %s not found", name)
+	}
+	s := &slice{fset: fset, file: file, lit: lit, comments: file.Comments}
+	if len(path) == 0 {
+		start, end := s.offset(lit.Pos()), s.offset(lit.End())
+		if normalize(string(f.src[start:end])) == normalize(value) {
+			return Unchanged, nil
+		}
+		if s.hasComment(lit.Pos(), lit.End()) {
+			return Unchanged, fmt.Errorf("%s: replacing the value would drop a comment", name)
+		}
+		return Updated, f.splice(start, end, value)
+	}
+	for i, field := range path {
+		kv := keyValue(lit, field)
+		if kv == nil {
+			return Unchanged, fmt.Errorf("%s has no field %s", name, strings.Join(path[:i+1], "."))
+		}
+		if i == len(path)-1 {
+			start, end := s.offset(kv.Value.Pos()), s.offset(kv.Value.End())
+			if normalize(string(f.src[start:end])) == normalize(value) {
+				return Unchanged, nil
+			}
+			if s.hasComment(kv.Value.Pos(), kv.Value.End()) {
+				return Unchanged, fmt.Errorf("%s.%s: replacing the value would drop a comment", name, strings.Join(path, "."))
+			}
+			return Updated, f.splice(start, end, value)
+		}
+		next := kv.Value
+		if u, ok := next.(*ast.UnaryExpr); ok {
+			next = u.X
+		}
+		if lit, _ = next.(*ast.CompositeLit); lit == nil {
+			return Unchanged, fmt.Errorf("%s.%s is not a composite literal", name, strings.Join(path[:i+1], "."))
+		}
+	}
+	return Unchanged, fmt.Errorf("no field given for %s", name)
+}
+
+// keyValue returns the element of lit setting field, or nil.
+func keyValue(lit *ast.CompositeLit, field string) *ast.KeyValueExpr {
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if key, ok := kv.Key.(*ast.Ident); ok && key.Name == field {
+				return kv
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,403 @@
+// Package rewrite inserts and updates model.ProjectRelease literals in the
+// <Project>ProjectReleases slices of pkg/project, and the elements of other
+// slices keyed by a Version field such as GKEReleaseDetails. SetField
+// updates single fields of other literals, such as a project's Versioning,
+// and Decode reads the elements of a slice back into Go values.
+//
+// Entries are located with go/ast and spliced in at their exact source
+// offsets, so every existing comment and the layout of untouched entries are
+// preserved. Slices are kept newest first in the order of the caller's
+// calver.Parser and the result is printed through go/format.
+//
+// Splicing text is deliberate. Editing the AST and printing it with
+// go/printer would re-attach comments by position, and the trailing
+// comments of these slices drift onto the wrong entry when elements are
+// inserted before them. The cost is that only whole literals or fields are
+// replaced, replacements that would swallow a comment are refused rather
+// than merged, and every splice is re-parsed so a bad edit fails before
+// anything is written.
+package rewrite
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+)
+
+var variablePattern = regexp.MustCompile(`^([A-Z]\w*)ProjectReleases$`)
+
+// Release is the content of one model.ProjectRelease literal.
+type Release struct {
+	// Project is the Go expression for the Project field of a new entry,
+	// e.g. "GKE.ID". It is required to insert an entry.
+	Project                string
+	Version                string
+	RelatedProjectReleases []string
+}
+
+// Change reports what Upsert did.
+type Change int
+
+const (
+	Unchanged Change = iota
+	Inserted
+	Updated
+)
+
+func (c Change) String() string {
+	switch c {
+	case Inserted:
+		return "inserted"
+	case Updated:
+		return "updated"
+	}
+	return "unchanged"
+}
+
+// File is a Go source file being rewritten.
+type File struct {
+	src []byte
+}
+
+// Load reads the Go file at path.
+func Load(path string) (*File, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(src)
+}
+
+// Parse returns a File for src, which must be valid Go.
+func Parse(src []byte) (*File, error) {
+	if _, err := parser.ParseFile(token.NewFileSet(), "", src, parser.ParseComments); err != nil {
+		return nil, err
+	}
+	return &File{src: src}, nil
+}
+
+// FindVariable returns the .go file in dir that declares the variable name
+// with a composite literal, e.g. the releases slice "GKEProjectReleases" or
+// the project "GKE" in pkg/project.
+func FindVariable(dir, name string) (string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", err
+	}
+	for _, path := range paths {
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return "", err
+		}
+		if findVar(file, name) != nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("%s not declared in %s", name, dir)
+}
+
+// Variables returns the <Project>ProjectReleases slices declared in the
+// file, in declaration order.
+func (f *File) Variables() []string {
+	file, _ := parser.ParseFile(token.NewFileSet(), "", f.src, 0)
+	var names []string
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			for _, ident := range spec.(*ast.ValueSpec).Names {
+				if variablePattern.MatchString(ident.Name) {
+					names = append(names, ident.Name)
+				}
+			}
+		}
+	}
+	return names
+}
+
+// Releases returns the entries of the slice name in file order.
+func (f *File) Releases(name string) ([]Release, error) {
+	s, err := f.locate(name)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Release, len(s.entries))
+	for i, e := range s.entries {
+		out[i] = e.Release
+	}
+	return out, nil
+}
+
+// Upsert inserts r into the slice name at the position that keeps it
+// newest first, or replaces the RelatedProjectReleases of the entry that
+// already has r.Version, padded or not. Versions are parsed with p.
+func (f *File) Upsert(p *calver.Parser, name string, r Release) (Change, error) {
+	if !variablePattern.MatchString(name) {
+		return Unchanged, fmt.Errorf("%s is not a <Project>ProjectReleases variable", name)
+	}
+	key, err := p.Parse(r.Version)
+	if err != nil {
+		return Unchanged, err
+	}
+	s, err := f.locate(name)
+	if err != nil {
+		return Unchanged, err
+	}
+
+	for _, e := range s.entries {
+		if v, err := p.Parse(e.Version); err != nil || v != key {
+			continue
+		}
+		if equal(e.RelatedProjectReleases, r.RelatedProjectReleases) {
+			return Unchanged, nil
+		}
+		if e.related == nil {
+			return Unchanged, fmt.Errorf("%s %s: RelatedProjectReleases is not a []string literal", name, r.Version)
+		}
+		if s.hasComment(e.related.Pos(), e.related.End()) {
+			return Unchanged, fmt.Errorf("%s %s: replacing RelatedProjectReleases would drop a comment", name, r.Version)
+		}
+		return Updated, f.splice(s.offset(e.related.Pos()), s.offset(e.related.End()), relatedLiteral(r.RelatedProjectReleases))
+	}
+
+	if r.Project == "" {
+		return Unchanged, fmt.Errorf("%s %s: no Project expression for the new entry", name, r.Version)
+	}
+	if _, err := parser.ParseExpr(r.Project); err != nil {
+		return Unchanged, fmt.Errorf("%s %s: invalid Project expression %q: %w", name, r.Version, r.Project, err)
+	}
+	var b strings.Builder
+	b.WriteString("{\n")
+	fmt.Fprintf(&b, "Project: %s,\n", r.Project)
+	fmt.Fprintf(&b, "Version: %s,\n", strconv.Quote(r.Version))
+	fmt.Fprintf(&b, "RelatedProjectReleases: %s,\n", relatedLiteral(r.RelatedProjectReleases))
+	b.WriteString("},\n")
+	return Inserted, f.insert(p, s, key, b.String())
+}
+
+// Put inserts literal, the Go source of one element whose Version field is
+// version, into the slice name so that it stays newest first, or replaces
+// the element that already has version. It works for any slice of struct
+// literals keyed by a Version field, such as GKEReleaseDetails. Versions
+// are parsed with p.
+func (f *File) Put(p *calver.Parser, name, version, literal string) (Change, error) {
+	key, err := p.Parse(version)
+	if err != nil {
+		return Unchanged, err
+	}
+	if _, err := parser.ParseExpr("[]T{" + literal + "}"); err != nil {
+		return Unchanged, fmt.Errorf("invalid element literal for %s: %w", version, err)
+	}
+	s, err := f.locate(name)
+	if err != nil {
+		return Unchanged, err
+	}
+	for _, e := range s.entries {
+		if v, err := p.Parse(e.Version); err != nil || v != key {
+			continue
+		}
+		start, end := s.offset(e.lit.Pos()), s.offset(e.lit.End())
+		if normalize(string(f.src[start:end])) == normalize(literal) {
+			return Unchanged, nil
+		}
+		if s.hasComment(e.lit.Pos(), e.lit.End()) {
+			return Unchanged, fmt.Errorf("%s %s: replacing the element would drop a comment", name, version)
+		}
+		return Updated, f.splice(start, end, literal)
+	}
+	return Inserted, f.insert(p, s, key, literal+",\n")
+}
+
+// insert adds text before the first entry of s older than key. Trailing
+// comments of the entry before it stay in place; when no entry is older,
+// text goes before the closing brace.
+func (f *File) insert(p *calver.Parser, s *slice, key calver.Version, text string) error {
+	at := s.offset(s.lit.Rbrace)
+	for _, e := range s.entries {
+		v, err := p.Parse(e.Version)
+		if err == nil && v.Less(key) {
+			at = s.offset(e.lit.Pos())
+			break
+		}
+	}
+	if line := f.src[bytes.LastIndexByte(f.src[:at], '\n')+1 : at]; len(bytes.TrimSpace(line)) > 0 {
+		text = "\n" + text
+	}
+	return f.splice(at, at, text)
+}
+
+// Bytes returns the gofmt-ed source.
+func (f *File) Bytes() ([]byte, error) {
+	return format.Source(f.src)
+}
+
+// Save writes the gofmt-ed source to path.
+func (f *File) Save(path string) error {
+	out, err := f.Bytes()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, out, 0o644)
+}
+
+func (f *File) splice(start, end int, text string) error {
+	out := make([]byte, 0, len(f.src)+len(text))
+	out = append(out, f.src[:start]...)
+	out = append(out, text...)
+	out = append(out, f.src[end:]...)
+	if _, err := parser.ParseFile(token.NewFileSet(), "", out, parser.ParseComments); err != nil {
+		return fmt.Errorf("rewritten source does not parse: %w", err)
+	}
+	f.src = out
+	return nil
+}
+
+// slice is a located releases slice.
+type slice struct {
+	fset     *token.FileSet
+	file     *ast.File
+	lit      *ast.CompositeLit
+	entries  []entry
+	comments []*ast.CommentGroup
+}
+
+type entry struct {
+	Release
+	lit     *ast.CompositeLit
+	related *ast.CompositeLit
+}
+
+func (f *File) locate(name string) (*slice, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", f.src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	lit := findVar(file, name)
+	if lit == nil {
+		return nil, fmt.Errorf("variable %s not found", name)
+	}
+	s := &slice{fset: fset, file: file, lit: lit, comments: file.Comments}
+	for _, elt := range lit.Elts {
+		cl, ok := elt.(*ast.CompositeLit)
+		if !ok {
+			return nil, fmt.Errorf("%s: unexpected element at %s", name, fset.Position(elt.Pos()))
+		}
+		e := entry{lit: cl}
+		for _, field := range cl.Elts {
+			kv, ok := field.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			key, _ := kv.Key.(*ast.Ident)
+			if key == nil {
+				continue
+			}
+			switch key.Name {
+			case "Version":
+				if bl, ok := kv.Value.(*ast.BasicLit); ok && bl.Kind == token.STRING {
+					e.Version, _ = strconv.Unquote(bl.Value)
+				}
+			case "RelatedProjectReleases":
+				related, ok := kv.Value.(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+				e.related = related
+				for _, r := range related.Elts {
+					if bl, ok := r.(*ast.BasicLit); ok && bl.Kind == token.STRING {
+						v, _ := strconv.Unquote(bl.Value)
+						e.RelatedProjectReleases = append(e.RelatedProjectReleases, v)
+					}
+				}
+			}
+		}
+		s.entries = append(s.entries, e)
+	}
+	return s, nil
+}
+
+func findVar(file *ast.File, name string) *ast.CompositeLit {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, ident := range vs.Names {
+				if ident.Name == name && i < len(vs.Values) {
+					lit, _ := vs.Values[i].(*ast.CompositeLit)
+					return lit
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *slice) offset(p token.Pos) int {
+	return s.fset.Position(p).Offset
+}
+
+func (s *slice) hasComment(start, end token.Pos) bool {
+	for _, c := range s.comments {
+		if c.Pos() >= start && c.End() <= end {
+			return true
+		}
+	}
+	return false
+}
+
+// relatedLiteral renders refs as a multi-line []string literal.
+func relatedLiteral(refs []string) string {
+	if len(refs) == 0 {
+		return "[]string{}"
+	}
+	var b strings.Builder
+	b.WriteString("[]string{\n")
+	for _, r := range refs {
+		b.WriteString(strconv.Quote(r))
+		b.WriteString(",\n")
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// normalize prints the element literal src in canonical form, or returns
+// src unchanged when it does not parse.
+func normalize(src string) string {
+	expr, err := parser.ParseExpr("[]T{" + src + "}")
+	if err != nil {
+		return src
+	}
+	var b bytes.Buffer
+	if err := format.Node(&b, token.NewFileSet(), expr); err != nil {
+		return src
+	}
+	return b.String()
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
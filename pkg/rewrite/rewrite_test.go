@@ -0,0 +1,283 @@
+package rewrite
+
+import (
+	"bytes"
+	"flag"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/abdulmajid3352/codecamp/pkg/calver"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// load returns testdata/releases.input as a File.
+func load(t *testing.T) (*File, []byte) {
+	t.Helper()
+	src, err := os.ReadFile(filepath.Join("testdata", "releases.input"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f, src
+}
+
+func gkeParser(t *testing.T) *calver.Parser {
+	t.Helper()
+	p, err := calver.NewParser([]string{"<YYYY>-R<MINOR>"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// checkGolden checks that the source of f parses, is gofmt-ed and matches
+// testdata/golden, or equals input when golden is empty.
+func checkGolden(t *testing.T, f *File, input []byte, golden string) {
+	t.Helper()
+	out, err := f.Bytes()
+	if err != nil {
+		t.Fatalf("rewritten source does not format: %v", err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "", out, parser.ParseComments); err != nil {
+		t.Fatalf("rewritten source does not parse: %v", err)
+	}
+	if formatted, err := format.Source(out); err != nil || !bytes.Equal(formatted, out) {
+		t.Errorf("rewritten source is not gofmt-ed (%v)", err)
+	}
+	want := input
+	if golden != "" {
+		path := filepath.Join("testdata", golden)
+		if *update {
+			if err := os.WriteFile(path, out, 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if want, err = os.ReadFile(path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !bytes.Equal(out, want) {
+		t.Errorf("rewritten source differs from %s:\n%s", golden, out)
+	}
+}
+
+func TestUpsert(t *testing.T) {
+	tests := []struct {
+		name    string
+		release Release
+		change  Change
+		golden  string
+	}{
+		{
+			name:    "insert newest",
+			release: Release{Project: "GKE.ID", Version: "2025-R38", RelatedProjectReleases: []string{"kube@1.32.7", "kube@1.33.4"}},
+			change:  Inserted,
+			golden:  "upsert_insert.golden",
+		},
+		{
+			name:    "insert between entries",
+			release: Release{Project: "GKE.ID", Version: "2025-R10", RelatedProjectReleases: []string{"kube@1.31.6"}},
+			change:  Inserted,
+			golden:  "upsert_insert_between.golden",
+		},
+		{
+			name:    "replace unpadded",
+			release: Release{Version: "2025-R9", RelatedProjectReleases: []string{"kube@1.31.5", "kube@1.31.6"}},
+			change:  Updated,
+			golden:  "upsert_replace.golden",
+		},
+		{
+			name:    "same versions",
+			release: Release{Version: "2025-R37", RelatedProjectReleases: []string{"kube@1.32.6", "kube@1.33.3"}},
+			change:  Unchanged,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, input := load(t)
+			change, err := f.Upsert(gkeParser(t), "GKEProjectReleases", tt.release)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if change != tt.change {
+				t.Errorf("Upsert = %s, want %s", change, tt.change)
+			}
+			checkGolden(t, f, input, tt.golden)
+		})
+	}
+}
+
+func TestUpsertErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		variable string
+		release  Release
+		want     string
+	}{
+		{"not a releases slice", "GKEReleaseDetails", Release{Version: "2025-R38"}, "is not a <Project>ProjectReleases variable"},
+		{"missing slice", "EKSProjectReleases", Release{Version: "2025-R38"}, "variable EKSProjectReleases not found"},
+		{"no project", "GKEProjectReleases", Release{Version: "2025-R38"}, "no Project expression"},
+		{"bad project", "GKEProjectReleases", Release{Project: "GKE.", Version: "2025-R38"}, "invalid Project expression"},
+	}
+	for _, tt := range tests {
+		f, input := load(t)
+		_, err := f.Upsert(gkeParser(t), tt.variable, tt.release)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: error %v, want %q", tt.name, err, tt.want)
+		}
+		checkGolden(t, f, input, "")
+	}
+}
+
+func TestPut(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		literal string
+		change  Change
+		golden  string
+	}{
+		{
+			name:    "insert",
+			version: "2025-R36",
+			literal: "{\nVersion: \"2025-R36\",\nPublished: \"2025-09-09\",\n}",
+			change:  Inserted,
+			golden:  "put_insert.golden",
+		},
+		{
+			name:    "replace",
+			version: "2025-R9",
+			literal: "{\nVersion: \"2025-R09\",\nPublished: \"2025-03-05\",\n}",
+			change:  Updated,
+			golden:  "put_replace.golden",
+		},
+		{
+			name:    "same element",
+			version: "2025-R09",
+			literal: "{\n\tVersion:   \"2025-R09\",\n\tPublished: \"2025-03-04\",\n}",
+			change:  Unchanged,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, input := load(t)
+			change, err := f.Put(gkeParser(t), "GKEReleaseDetails", tt.version, tt.literal)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if change != tt.change {
+				t.Errorf("Put = %s, want %s", change, tt.change)
+			}
+			checkGolden(t, f, input, tt.golden)
+		})
+	}
+}
+
+func TestSetField(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   []string
+		value  string
+		change Change
+		golden string
+		err    string
+	}{
+		{
+			name:   "nested field behind &",
+			path:   []string{"Versioning", "ReleaseCycle"},
+			value:  "model.ReleaseCycleWeekly",
+			change: Updated,
+			golden: "setfield_replace.golden",
+		},
+		{
+			name:   "same value",
+			path:   []string{"Versioning", "ReleasePatterns"},
+			value:  `[]string{"<YYYY>-R<MINOR>"}`,
+			change: Unchanged,
+		},
+		{
+			name:  "value with a comment",
+			path:  []string{"Versioning"},
+			value: "nil",
+			err:   "would drop a comment",
+		},
+		{
+			name:  "missing field",
+			path:  []string{"Versioning", "Scheme"},
+			value: "1",
+			err:   "GKE has no field Versioning.Scheme",
+		},
+		{
+			name:  "invalid value",
+			path:  []string{"ID"},
+			value: "model.",
+			err:   "invalid value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, input := load(t)
+			change, err := f.SetField("GKE", tt.path, tt.value)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Errorf("error %v, want %q", err, tt.err)
+				}
+				checkGolden(t, f, input, "")
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if change != tt.change {
+				t.Errorf("SetField = %s, want %s", change, tt.change)
+			}
+			checkGolden(t, f, input, tt.golden)
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	type source struct{ URL, Anchor string }
+	type build struct{ Channel, Role, Version string }
+	type detail struct {
+		Version   string
+		Source    *source
+		Published string
+		Builds    []build
+	}
+	f, _ := load(t)
+	var got []detail
+	idents := map[string]any{"ChannelStable": "Stable", "RoleDefault": "default"}
+	if err := f.Decode("GKEReleaseDetails", &got, idents); err != nil {
+		t.Fatal(err)
+	}
+	want := []detail{
+		{
+			Version: "2025-R37",
+			Source:  &source{URL: "https://cloud.google.com/kubernetes-engine/docs/release-notes", Anchor: "2025-r37_version_updates"},
+			Builds:  []build{{Channel: "Stable", Role: "default", Version: "1.32.6-gke.1125000"}},
+		},
+		{Version: "2025-R09", Published: "2025-03-04"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Decode = %+v\nwant %+v", got, want)
+	}
+
+	var details []detail
+	if err := f.Decode("GKEReleaseDetails", &details, nil); err == nil || !strings.Contains(err.Error(), "ChannelStable") {
+		t.Errorf("Decode without idents: error %v, want one naming ChannelStable", err)
+	}
+	if err := f.Decode("GKEReleaseDetails", details, idents); err == nil {
+		t.Error("Decode into a slice value succeeded, want an error")
+	}
+}
@@ -0,0 +1,82 @@
+package scrape
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Text returns the whitespace-normalized text content of n.
+func Text(n *html.Node) string {
+	var b strings.Builder
+	Walk(n, func(c *html.Node) {
+		if c.Type == html.TextNode {
+			b.WriteString(c.Data)
+			b.WriteByte(' ')
+		}
+	})
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// Attr returns the value of the attribute key of n, or "".
+func Attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// Walk calls fn for n and every node below it in document order.
+func Walk(n *html.Node, fn func(*html.Node)) {
+	fn(n)
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		Walk(c, fn)
+	}
+}
+
+// HeadingLevel returns 1 to 6 for <h1> to <h6> elements and 0 otherwise.
+func HeadingLevel(n *html.Node) int {
+	if n.Type != html.ElementNode {
+		return 0
+	}
+	switch n.DataAtom {
+	case atom.H1:
+		return 1
+	case atom.H2:
+		return 2
+	case atom.H3:
+		return 3
+	case atom.H4:
+		return 4
+	case atom.H5:
+		return 5
+	case atom.H6:
+		return 6
+	}
+	return 0
+}
+
+// FindByID returns the first element below n whose id is id.
+func FindByID(n *html.Node, id string) *html.Node {
+	if id == "" {
+		return nil
+	}
+	var found *html.Node
+	Walk(n, func(c *html.Node) {
+		if found == nil && c.Type == html.ElementNode && Attr(c, "id") == id {
+			found = c
+		}
+	})
+	return found
+}
+
+// Root returns the document node n belongs to.
+func Root(n *html.Node) *html.Node {
+	for n.Parent != nil {
+		n = n.Parent
+	}
+	return n
+}
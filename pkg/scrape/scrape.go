@@ -0,0 +1,218 @@
+// Package scrape executes the SourceScrapeConfig of a project curation
+// config.
+//
+// For every source of a model.ProjectCurationConfig it loads the page named
+// by the source's LinkTemplate, or a local snapshot of it, restricts the
+// document to TargetCSSSelector and splits it into sections at the headings
+// whose text matches SectionPattern. Adding a provider whose release notes
+// follow this shape takes configuration only.
+package scrape
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+
+	"github.com/andybalholm/cascadia"
+	"github.com/chkk-io/schema/model"
+	"golang.org/x/net/html"
+)
+
+// Section is one section of a scraped page.
+type Section struct {
+	// Source is the curation source the section was scraped from.
+	Source *model.LinkTemplateCurationConfig
+	// Heading is the text of the section heading.
+	Heading string
+	// Key is the first SectionPattern capture group, or the whole match
+	// when the pattern has none, e.g. "2025-R37".
+	Key string
+	// Anchor is the id of the heading element, if any.
+	Anchor string
+	// Parent is the text of the nearest preceding heading of a higher
+	// level, if any, e.g. the date a release notes entry is filed under.
+	Parent string
+	// URL links to the section within the source page.
+	URL string
+	// Body holds the nodes between the heading and the next heading of the
+	// same or a higher level.
+	Body []*html.Node
+}
+
+// Text returns the text content of the section body.
+func (s *Section) Text() string {
+	var b bytes.Buffer
+	for _, n := range s.Body {
+		b.WriteString(Text(n))
+		b.WriteByte(' ')
+	}
+	return string(bytes.TrimSpace(b.Bytes()))
+}
+
+// HTML returns the rendered section body.
+func (s *Section) HTML() (string, error) {
+	var b bytes.Buffer
+	for _, n := range s.Body {
+		if err := html.Render(&b, n); err != nil {
+			return "", err
+		}
+	}
+	return b.String(), nil
+}
+
+// Fetcher returns the content of the page at url.
+type Fetcher func(url string) (io.ReadCloser, error)
+
+// HTTP fetches pages over the network with client, or http.DefaultClient
+// when client is nil.
+func HTTP(client *http.Client) Fetcher {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return func(url string) (io.ReadCloser, error) {
+		resp, err := client.Get(url)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("fetching %s: %s", url, resp.Status)
+		}
+		return resp.Body, nil
+	}
+}
+
+// Snapshot serves every page from the saved HTML file at path, so a config
+// can be scraped without network access.
+func Snapshot(path string) Fetcher {
+	return func(string) (io.ReadCloser, error) {
+		return os.Open(path)
+	}
+}
+
+// Engine scrapes the sources of project curation configs.
+type Engine struct {
+	// Fetch loads source pages. Defaults to HTTP(nil).
+	Fetch Fetcher
+}
+
+// Scrape returns the sections of every scrape-enabled source in cfg, source
+// by source in page order.
+func (e *Engine) Scrape(cfg *model.ProjectCurationConfig) ([]*Section, error) {
+	if cfg == nil || cfg.Series == nil {
+		return nil, fmt.Errorf("curation config has no release series")
+	}
+	var sections []*Section
+	for _, src := range cfg.Series.Sources {
+		if src == nil || src.Scrape == nil {
+			continue
+		}
+		s, err := e.ScrapeSource(src)
+		if err != nil {
+			return nil, err
+		}
+		sections = append(sections, s...)
+	}
+	return sections, nil
+}
+
+// ScrapeSource loads and parses a single source.
+func (e *Engine) ScrapeSource(src *model.LinkTemplateCurationConfig) ([]*Section, error) {
+	fetch := e.Fetch
+	if fetch == nil {
+		fetch = HTTP(nil)
+	}
+	url := src.LinkTemplate.URLTemplate
+	rc, err := fetch(url)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	sections, err := Parse(rc, src)
+	if err != nil {
+		return nil, fmt.Errorf("scraping %s: %w", url, err)
+	}
+	return sections, nil
+}
+
+// Parse splits the page read from r into the sections described by
+// src.Scrape.
+func Parse(r io.Reader, src *model.LinkTemplateCurationConfig) ([]*Section, error) {
+	if src.Scrape == nil {
+		return nil, fmt.Errorf("source %s has no scrape config", src.LinkTemplate.URLTemplate)
+	}
+	pattern, err := regexp.Compile(src.Scrape.SectionPattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid section pattern: %w", err)
+	}
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	roots := []*html.Node{doc}
+	if sel := src.Scrape.TargetCSSSelector; sel != "" {
+		matcher, err := cascadia.Compile(sel)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target selector %q: %w", sel, err)
+		}
+		roots = cascadia.QueryAll(doc, matcher)
+		if len(roots) == 0 {
+			return nil, fmt.Errorf("target selector %q matched nothing", sel)
+		}
+	}
+
+	var sections []*Section
+	// headings holds the text of the last heading seen at each level.
+	var headings [7]string
+	var visit func(n *html.Node)
+	visit = func(n *html.Node) {
+		if level := HeadingLevel(n); level > 0 {
+			heading := Text(n)
+			headings[level] = heading
+			for l := level + 1; l < len(headings); l++ {
+				headings[l] = ""
+			}
+			if m := pattern.FindStringSubmatch(heading); m != nil {
+				s := newSection(src, n, level, heading, m)
+				for l := level - 1; l > 0 && s.Parent == ""; l-- {
+					s.Parent = headings[l]
+				}
+				sections = append(sections, s)
+				return
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			visit(c)
+		}
+	}
+	for _, root := range roots {
+		visit(root)
+	}
+	return sections, nil
+}
+
+func newSection(src *model.LinkTemplateCurationConfig, heading *html.Node, level int, text string, m []string) *Section {
+	s := &Section{
+		Source:  src,
+		Heading: text,
+		Key:     m[0],
+		Anchor:  Attr(heading, "id"),
+		URL:     src.LinkTemplate.URLTemplate,
+	}
+	if len(m) > 1 {
+		s.Key = m[1]
+	}
+	if s.Anchor != "" {
+		s.URL += "#" + s.Anchor
+	}
+	for n := heading.NextSibling; n != nil; n = n.NextSibling {
+		if l := HeadingLevel(n); l > 0 && l <= level {
+			break
+		}
+		s.Body = append(s.Body, n)
+	}
+	return s
+}
@@ -0,0 +1,96 @@
+package scrape
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/chkk-io/schema/model"
+)
+
+const notesURL = "https://example.com/release-notes"
+
+func source(selector string) *model.LinkTemplateCurationConfig {
+	return &model.LinkTemplateCurationConfig{
+		Scrape: &model.SourceScrapeConfig{
+			TargetCSSSelector: selector,
+			SectionPattern:    `\((\d{4}-R\d+)\) Version updates`,
+		},
+		LinkTemplate: model.LinkTemplate{URLTemplate: notesURL},
+	}
+}
+
+func TestScrape(t *testing.T) {
+	cfg := &model.ProjectCurationConfig{
+		Series: &model.ReleaseCurationConfig{
+			Sources: []*model.LinkTemplateCurationConfig{
+				source("#main-content"),
+				// Sources without a scrape config are skipped.
+				{LinkTemplate: model.LinkTemplate{URLTemplate: "https://example.com/other"}},
+			},
+		},
+	}
+	var fetched []string
+	snapshot := Snapshot("testdata/release_notes.html")
+	e := &Engine{Fetch: func(url string) (io.ReadCloser, error) {
+		fetched = append(fetched, url)
+		return snapshot(url)
+	}}
+	sections, err := e.Scrape(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fetched) != 1 || fetched[0] != notesURL {
+		t.Errorf("fetched %v, want only %s", fetched, notesURL)
+	}
+	want := []struct {
+		key, anchor, parent, url, text string
+	}{
+		{
+			key:    "2025-R39",
+			anchor: "2025-r39_version_updates",
+			parent: "October 01, 2025",
+			url:    notesURL + "#2025-r39_version_updates",
+			text:   "Version 1.32.7-gke.1079000 is now the default. Stable 1.31.12-gke.1265000 is now available.",
+		},
+		{
+			key:    "2025-R38",
+			parent: "September 23, 2025",
+			url:    notesURL,
+			text:   "Version 1.34.0-gke.2201000 is now available.",
+		},
+	}
+	if len(sections) != len(want) {
+		t.Fatalf("got %d sections, want %d", len(sections), len(want))
+	}
+	for i, w := range want {
+		s := sections[i]
+		text := strings.Join(strings.Fields(s.Text()), " ")
+		if s.Key != w.key || s.Anchor != w.anchor || s.Parent != w.parent || s.URL != w.url || text != w.text {
+			t.Errorf("section %d = %q anchor %q parent %q url %q text %q\nwant %q anchor %q parent %q url %q text %q",
+				i, s.Key, s.Anchor, s.Parent, s.URL, text, w.key, w.anchor, w.parent, w.url, w.text)
+		}
+		if s.Source != cfg.Series.Sources[0] {
+			t.Errorf("section %s has source %v", s.Key, s.Source)
+		}
+	}
+}
+
+func TestScrapeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  *model.LinkTemplateCurationConfig
+		want string
+	}{
+		{"selector matching nothing", source("#missing"), `target selector "#missing" matched nothing`},
+		{"invalid selector", source("[["), "invalid target selector"},
+		{"invalid pattern", &model.LinkTemplateCurationConfig{Scrape: &model.SourceScrapeConfig{SectionPattern: "("}}, "invalid section pattern"},
+	}
+	e := &Engine{Fetch: Snapshot("testdata/release_notes.html")}
+	for _, tt := range tests {
+		_, err := e.ScrapeSource(tt.src)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: error %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}